@@ -5,7 +5,7 @@ go 1.16
 require (
 	github.com/elastic/go-windows v1.0.1 // indirect
 	github.com/gin-gonic/gin v1.7.1 // indirect
-	github.com/golang/protobuf v1.5.2 // indirect
+	github.com/golang/protobuf v1.5.2
 	github.com/grpc-ecosystem/go-grpc-middleware v1.1.0
 	github.com/kr/text v0.2.0 // indirect
 	github.com/meateam/elasticsearch-logger v1.2.0
@@ -19,7 +19,7 @@ require (
 	go.mongodb.org/mongo-driver v1.5.1
 	golang.org/x/net v0.0.0-20201021035429-f5854403a974 // indirect
 	golang.org/x/sys v0.0.0-20201020230747-6e5568b54d1a // indirect
-	google.golang.org/genproto v0.0.0-20201021134325-0d71844de594 // indirect
+	google.golang.org/genproto v0.0.0-20201021134325-0d71844de594
 	google.golang.org/grpc v1.37.0
 	google.golang.org/grpc/examples v0.0.0-20201021230544-4e8458e5c638 // indirect
 	google.golang.org/protobuf v1.26.0
@@ -21,6 +21,29 @@ type FavoriteClient interface {
 	CreateFavorite(ctx context.Context, in *CreateFavoriteRequest, opts ...grpc.CallOption) (*FavoriteObject, error)
 	DeleteFavorite(ctx context.Context, in *DeleteFavoriteRequest, opts ...grpc.CallOption) (*FavoriteObject, error)
 	GetAllFavorites(ctx context.Context, in *GetAllFavoritesRequest, opts ...grpc.CallOption) (*GetAllFavoritesResponse, error)
+	ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (Favorite_ListFavoritesClient, error)
+	IsFavorite(ctx context.Context, in *IsFavoriteRequest, opts ...grpc.CallOption) (*IsFavoriteResponse, error)
+	CreateFavorites(ctx context.Context, in *CreateFavoritesRequest, opts ...grpc.CallOption) (*BatchFavoritesResponse, error)
+	DeleteFavorites(ctx context.Context, in *DeleteFavoritesRequest, opts ...grpc.CallOption) (*BatchFavoritesResponse, error)
+	GetFileFavoriters(ctx context.Context, in *GetFileFavoritersRequest, opts ...grpc.CallOption) (*GetFileFavoritersResponse, error)
+	CountFileFavorites(ctx context.Context, in *CountFileFavoritesRequest, opts ...grpc.CallOption) (*CountFileFavoritesResponse, error)
+	DeleteFavoritesByFile(ctx context.Context, in *DeleteFavoritesByFileRequest, opts ...grpc.CallOption) (*DeleteFavoritesByFileResponse, error)
+	DeleteFavoritesByFiles(ctx context.Context, in *DeleteFavoritesByFilesRequest, opts ...grpc.CallOption) (*DeleteFavoritesByFileResponse, error)
+	DeleteAllUserFavorites(ctx context.Context, in *DeleteAllUserFavoritesRequest, opts ...grpc.CallOption) (*DeleteAllUserFavoritesResponse, error)
+	TransferFavorites(ctx context.Context, in *TransferFavoritesRequest, opts ...grpc.CallOption) (*TransferFavoritesResponse, error)
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CollectionObject, error)
+	GetCollections(ctx context.Context, in *GetCollectionsRequest, opts ...grpc.CallOption) (*GetCollectionsResponse, error)
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*CollectionObject, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*CollectionObject, error)
+	AddToCollections(ctx context.Context, in *CollectionFavoritesRequest, opts ...grpc.CallOption) (*BatchFavoritesResponse, error)
+	RemoveFromCollections(ctx context.Context, in *CollectionFavoritesRequest, opts ...grpc.CallOption) (*BatchFavoritesResponse, error)
+	UpdateFavorite(ctx context.Context, in *UpdateFavoriteRequest, opts ...grpc.CallOption) (*FavoriteObject, error)
+	ReorderFavorites(ctx context.Context, in *ReorderFavoritesRequest, opts ...grpc.CallOption) (*FavoriteObject, error)
+	RestoreFavorite(ctx context.Context, in *RestoreFavoriteRequest, opts ...grpc.CallOption) (*FavoriteObject, error)
+	ListRecentlyDeletedFavorites(ctx context.Context, in *ListRecentlyDeletedFavoritesRequest, opts ...grpc.CallOption) (*ListRecentlyDeletedFavoritesResponse, error)
+	GetFavoriteQuota(ctx context.Context, in *GetFavoriteQuotaRequest, opts ...grpc.CallOption) (*FavoriteQuota, error)
+	SetFavoriteQuota(ctx context.Context, in *SetFavoriteQuotaRequest, opts ...grpc.CallOption) (*FavoriteQuota, error)
+	WatchFavorites(ctx context.Context, in *WatchFavoritesRequest, opts ...grpc.CallOption) (Favorite_WatchFavoritesClient, error)
 }
 
 type favoriteClient struct {
@@ -58,6 +81,259 @@ func (c *favoriteClient) GetAllFavorites(ctx context.Context, in *GetAllFavorite
 	return out, nil
 }
 
+func (c *favoriteClient) ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (Favorite_ListFavoritesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Favorite_ServiceDesc.Streams[0], "/favorite.Favorite/ListFavorites", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &favoriteListFavoritesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Favorite_ListFavoritesClient interface {
+	Recv() (*FavoriteObject, error)
+	grpc.ClientStream
+}
+
+type favoriteListFavoritesClient struct {
+	grpc.ClientStream
+}
+
+func (x *favoriteListFavoritesClient) Recv() (*FavoriteObject, error) {
+	m := new(FavoriteObject)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *favoriteClient) IsFavorite(ctx context.Context, in *IsFavoriteRequest, opts ...grpc.CallOption) (*IsFavoriteResponse, error) {
+	out := new(IsFavoriteResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/IsFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) CreateFavorites(ctx context.Context, in *CreateFavoritesRequest, opts ...grpc.CallOption) (*BatchFavoritesResponse, error) {
+	out := new(BatchFavoritesResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/CreateFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) DeleteFavorites(ctx context.Context, in *DeleteFavoritesRequest, opts ...grpc.CallOption) (*BatchFavoritesResponse, error) {
+	out := new(BatchFavoritesResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/DeleteFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) GetFileFavoriters(ctx context.Context, in *GetFileFavoritersRequest, opts ...grpc.CallOption) (*GetFileFavoritersResponse, error) {
+	out := new(GetFileFavoritersResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/GetFileFavoriters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) CountFileFavorites(ctx context.Context, in *CountFileFavoritesRequest, opts ...grpc.CallOption) (*CountFileFavoritesResponse, error) {
+	out := new(CountFileFavoritesResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/CountFileFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) DeleteFavoritesByFile(ctx context.Context, in *DeleteFavoritesByFileRequest, opts ...grpc.CallOption) (*DeleteFavoritesByFileResponse, error) {
+	out := new(DeleteFavoritesByFileResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/DeleteFavoritesByFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) DeleteFavoritesByFiles(ctx context.Context, in *DeleteFavoritesByFilesRequest, opts ...grpc.CallOption) (*DeleteFavoritesByFileResponse, error) {
+	out := new(DeleteFavoritesByFileResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/DeleteFavoritesByFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) DeleteAllUserFavorites(ctx context.Context, in *DeleteAllUserFavoritesRequest, opts ...grpc.CallOption) (*DeleteAllUserFavoritesResponse, error) {
+	out := new(DeleteAllUserFavoritesResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/DeleteAllUserFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) TransferFavorites(ctx context.Context, in *TransferFavoritesRequest, opts ...grpc.CallOption) (*TransferFavoritesResponse, error) {
+	out := new(TransferFavoritesResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/TransferFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CollectionObject, error) {
+	out := new(CollectionObject)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/CreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) GetCollections(ctx context.Context, in *GetCollectionsRequest, opts ...grpc.CallOption) (*GetCollectionsResponse, error) {
+	out := new(GetCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/GetCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*CollectionObject, error) {
+	out := new(CollectionObject)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/UpdateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*CollectionObject, error) {
+	out := new(CollectionObject)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/DeleteCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) AddToCollections(ctx context.Context, in *CollectionFavoritesRequest, opts ...grpc.CallOption) (*BatchFavoritesResponse, error) {
+	out := new(BatchFavoritesResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/AddToCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) RemoveFromCollections(ctx context.Context, in *CollectionFavoritesRequest, opts ...grpc.CallOption) (*BatchFavoritesResponse, error) {
+	out := new(BatchFavoritesResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/RemoveFromCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) UpdateFavorite(ctx context.Context, in *UpdateFavoriteRequest, opts ...grpc.CallOption) (*FavoriteObject, error) {
+	out := new(FavoriteObject)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/UpdateFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) ReorderFavorites(ctx context.Context, in *ReorderFavoritesRequest, opts ...grpc.CallOption) (*FavoriteObject, error) {
+	out := new(FavoriteObject)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/ReorderFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) RestoreFavorite(ctx context.Context, in *RestoreFavoriteRequest, opts ...grpc.CallOption) (*FavoriteObject, error) {
+	out := new(FavoriteObject)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/RestoreFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) ListRecentlyDeletedFavorites(ctx context.Context, in *ListRecentlyDeletedFavoritesRequest, opts ...grpc.CallOption) (*ListRecentlyDeletedFavoritesResponse, error) {
+	out := new(ListRecentlyDeletedFavoritesResponse)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/ListRecentlyDeletedFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) GetFavoriteQuota(ctx context.Context, in *GetFavoriteQuotaRequest, opts ...grpc.CallOption) (*FavoriteQuota, error) {
+	out := new(FavoriteQuota)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/GetFavoriteQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) SetFavoriteQuota(ctx context.Context, in *SetFavoriteQuotaRequest, opts ...grpc.CallOption) (*FavoriteQuota, error) {
+	out := new(FavoriteQuota)
+	err := c.cc.Invoke(ctx, "/favorite.Favorite/SetFavoriteQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteClient) WatchFavorites(ctx context.Context, in *WatchFavoritesRequest, opts ...grpc.CallOption) (Favorite_WatchFavoritesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Favorite_ServiceDesc.Streams[1], "/favorite.Favorite/WatchFavorites", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &favoriteWatchFavoritesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Favorite_WatchFavoritesClient interface {
+	Recv() (*FavoriteChange, error)
+	grpc.ClientStream
+}
+
+type favoriteWatchFavoritesClient struct {
+	grpc.ClientStream
+}
+
+func (x *favoriteWatchFavoritesClient) Recv() (*FavoriteChange, error) {
+	m := new(FavoriteChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // FavoriteServer is the server API for Favorite service.
 // All implementations must embed UnimplementedFavoriteServer
 // for forward compatibility
@@ -65,6 +341,29 @@ type FavoriteServer interface {
 	CreateFavorite(context.Context, *CreateFavoriteRequest) (*FavoriteObject, error)
 	DeleteFavorite(context.Context, *DeleteFavoriteRequest) (*FavoriteObject, error)
 	GetAllFavorites(context.Context, *GetAllFavoritesRequest) (*GetAllFavoritesResponse, error)
+	ListFavorites(*ListFavoritesRequest, Favorite_ListFavoritesServer) error
+	IsFavorite(context.Context, *IsFavoriteRequest) (*IsFavoriteResponse, error)
+	CreateFavorites(context.Context, *CreateFavoritesRequest) (*BatchFavoritesResponse, error)
+	DeleteFavorites(context.Context, *DeleteFavoritesRequest) (*BatchFavoritesResponse, error)
+	GetFileFavoriters(context.Context, *GetFileFavoritersRequest) (*GetFileFavoritersResponse, error)
+	CountFileFavorites(context.Context, *CountFileFavoritesRequest) (*CountFileFavoritesResponse, error)
+	DeleteFavoritesByFile(context.Context, *DeleteFavoritesByFileRequest) (*DeleteFavoritesByFileResponse, error)
+	DeleteFavoritesByFiles(context.Context, *DeleteFavoritesByFilesRequest) (*DeleteFavoritesByFileResponse, error)
+	DeleteAllUserFavorites(context.Context, *DeleteAllUserFavoritesRequest) (*DeleteAllUserFavoritesResponse, error)
+	TransferFavorites(context.Context, *TransferFavoritesRequest) (*TransferFavoritesResponse, error)
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CollectionObject, error)
+	GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error)
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*CollectionObject, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*CollectionObject, error)
+	AddToCollections(context.Context, *CollectionFavoritesRequest) (*BatchFavoritesResponse, error)
+	RemoveFromCollections(context.Context, *CollectionFavoritesRequest) (*BatchFavoritesResponse, error)
+	UpdateFavorite(context.Context, *UpdateFavoriteRequest) (*FavoriteObject, error)
+	ReorderFavorites(context.Context, *ReorderFavoritesRequest) (*FavoriteObject, error)
+	RestoreFavorite(context.Context, *RestoreFavoriteRequest) (*FavoriteObject, error)
+	ListRecentlyDeletedFavorites(context.Context, *ListRecentlyDeletedFavoritesRequest) (*ListRecentlyDeletedFavoritesResponse, error)
+	GetFavoriteQuota(context.Context, *GetFavoriteQuotaRequest) (*FavoriteQuota, error)
+	SetFavoriteQuota(context.Context, *SetFavoriteQuotaRequest) (*FavoriteQuota, error)
+	WatchFavorites(*WatchFavoritesRequest, Favorite_WatchFavoritesServer) error
 	mustEmbedUnimplementedFavoriteServer()
 }
 
@@ -81,6 +380,75 @@ func (UnimplementedFavoriteServer) DeleteFavorite(context.Context, *DeleteFavori
 func (UnimplementedFavoriteServer) GetAllFavorites(context.Context, *GetAllFavoritesRequest) (*GetAllFavoritesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllFavorites not implemented")
 }
+func (UnimplementedFavoriteServer) ListFavorites(*ListFavoritesRequest, Favorite_ListFavoritesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedFavoriteServer) IsFavorite(context.Context, *IsFavoriteRequest) (*IsFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFavorite not implemented")
+}
+func (UnimplementedFavoriteServer) CreateFavorites(context.Context, *CreateFavoritesRequest) (*BatchFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFavorites not implemented")
+}
+func (UnimplementedFavoriteServer) DeleteFavorites(context.Context, *DeleteFavoritesRequest) (*BatchFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFavorites not implemented")
+}
+func (UnimplementedFavoriteServer) GetFileFavoriters(context.Context, *GetFileFavoritersRequest) (*GetFileFavoritersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileFavoriters not implemented")
+}
+func (UnimplementedFavoriteServer) CountFileFavorites(context.Context, *CountFileFavoritesRequest) (*CountFileFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountFileFavorites not implemented")
+}
+func (UnimplementedFavoriteServer) DeleteFavoritesByFile(context.Context, *DeleteFavoritesByFileRequest) (*DeleteFavoritesByFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFavoritesByFile not implemented")
+}
+func (UnimplementedFavoriteServer) DeleteFavoritesByFiles(context.Context, *DeleteFavoritesByFilesRequest) (*DeleteFavoritesByFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFavoritesByFiles not implemented")
+}
+func (UnimplementedFavoriteServer) DeleteAllUserFavorites(context.Context, *DeleteAllUserFavoritesRequest) (*DeleteAllUserFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAllUserFavorites not implemented")
+}
+func (UnimplementedFavoriteServer) TransferFavorites(context.Context, *TransferFavoritesRequest) (*TransferFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferFavorites not implemented")
+}
+func (UnimplementedFavoriteServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CollectionObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedFavoriteServer) GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollections not implemented")
+}
+func (UnimplementedFavoriteServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*CollectionObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedFavoriteServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*CollectionObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedFavoriteServer) AddToCollections(context.Context, *CollectionFavoritesRequest) (*BatchFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToCollections not implemented")
+}
+func (UnimplementedFavoriteServer) RemoveFromCollections(context.Context, *CollectionFavoritesRequest) (*BatchFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromCollections not implemented")
+}
+func (UnimplementedFavoriteServer) UpdateFavorite(context.Context, *UpdateFavoriteRequest) (*FavoriteObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFavorite not implemented")
+}
+func (UnimplementedFavoriteServer) ReorderFavorites(context.Context, *ReorderFavoritesRequest) (*FavoriteObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderFavorites not implemented")
+}
+func (UnimplementedFavoriteServer) RestoreFavorite(context.Context, *RestoreFavoriteRequest) (*FavoriteObject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreFavorite not implemented")
+}
+func (UnimplementedFavoriteServer) ListRecentlyDeletedFavorites(context.Context, *ListRecentlyDeletedFavoritesRequest) (*ListRecentlyDeletedFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecentlyDeletedFavorites not implemented")
+}
+func (UnimplementedFavoriteServer) GetFavoriteQuota(context.Context, *GetFavoriteQuotaRequest) (*FavoriteQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavoriteQuota not implemented")
+}
+func (UnimplementedFavoriteServer) SetFavoriteQuota(context.Context, *SetFavoriteQuotaRequest) (*FavoriteQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFavoriteQuota not implemented")
+}
+func (UnimplementedFavoriteServer) WatchFavorites(*WatchFavoritesRequest, Favorite_WatchFavoritesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchFavorites not implemented")
+}
 func (UnimplementedFavoriteServer) mustEmbedUnimplementedFavoriteServer() {}
 
 // UnsafeFavoriteServer may be embedded to opt out of forward compatibility for this service.
@@ -148,26 +516,541 @@ func _Favorite_GetAllFavorites_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-// Favorite_ServiceDesc is the grpc.ServiceDesc for Favorite service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Favorite_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "favorite.Favorite",
-	HandlerType: (*FavoriteServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateFavorite",
-			Handler:    _Favorite_CreateFavorite_Handler,
-		},
-		{
-			MethodName: "DeleteFavorite",
-			Handler:    _Favorite_DeleteFavorite_Handler,
-		},
-		{
-			MethodName: "GetAllFavorites",
-			Handler:    _Favorite_GetAllFavorites_Handler,
+func _Favorite_ListFavorites_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFavoritesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FavoriteServer).ListFavorites(m, &favoriteListFavoritesServer{stream})
+}
+
+type Favorite_ListFavoritesServer interface {
+	Send(*FavoriteObject) error
+	grpc.ServerStream
+}
+
+type favoriteListFavoritesServer struct {
+	grpc.ServerStream
+}
+
+func (x *favoriteListFavoritesServer) Send(m *FavoriteObject) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Favorite_IsFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).IsFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/IsFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).IsFavorite(ctx, req.(*IsFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_CreateFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).CreateFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/CreateFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).CreateFavorites(ctx, req.(*CreateFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_DeleteFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).DeleteFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/DeleteFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).DeleteFavorites(ctx, req.(*DeleteFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_GetFileFavoriters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileFavoritersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).GetFileFavoriters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/GetFileFavoriters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).GetFileFavoriters(ctx, req.(*GetFileFavoritersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_CountFileFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountFileFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).CountFileFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/CountFileFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).CountFileFavorites(ctx, req.(*CountFileFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_DeleteFavoritesByFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFavoritesByFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).DeleteFavoritesByFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/DeleteFavoritesByFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).DeleteFavoritesByFile(ctx, req.(*DeleteFavoritesByFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_DeleteFavoritesByFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFavoritesByFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).DeleteFavoritesByFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/DeleteFavoritesByFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).DeleteFavoritesByFiles(ctx, req.(*DeleteFavoritesByFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_DeleteAllUserFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAllUserFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).DeleteAllUserFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/DeleteAllUserFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).DeleteAllUserFavorites(ctx, req.(*DeleteAllUserFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_TransferFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).TransferFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/TransferFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).TransferFavorites(ctx, req.(*TransferFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/CreateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_GetCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).GetCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/GetCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).GetCollections(ctx, req.(*GetCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/UpdateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/DeleteCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_AddToCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).AddToCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/AddToCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).AddToCollections(ctx, req.(*CollectionFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_RemoveFromCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).RemoveFromCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/RemoveFromCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).RemoveFromCollections(ctx, req.(*CollectionFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_UpdateFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).UpdateFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/UpdateFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).UpdateFavorite(ctx, req.(*UpdateFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_ReorderFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).ReorderFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/ReorderFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).ReorderFavorites(ctx, req.(*ReorderFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_RestoreFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).RestoreFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/RestoreFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).RestoreFavorite(ctx, req.(*RestoreFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_ListRecentlyDeletedFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecentlyDeletedFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).ListRecentlyDeletedFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/ListRecentlyDeletedFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).ListRecentlyDeletedFavorites(ctx, req.(*ListRecentlyDeletedFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_GetFavoriteQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFavoriteQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).GetFavoriteQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/GetFavoriteQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).GetFavoriteQuota(ctx, req.(*GetFavoriteQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_SetFavoriteQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFavoriteQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteServer).SetFavoriteQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/favorite.Favorite/SetFavoriteQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteServer).SetFavoriteQuota(ctx, req.(*SetFavoriteQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Favorite_WatchFavorites_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFavoritesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FavoriteServer).WatchFavorites(m, &favoriteWatchFavoritesServer{stream})
+}
+
+type Favorite_WatchFavoritesServer interface {
+	Send(*FavoriteChange) error
+	grpc.ServerStream
+}
+
+type favoriteWatchFavoritesServer struct {
+	grpc.ServerStream
+}
+
+func (x *favoriteWatchFavoritesServer) Send(m *FavoriteChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Favorite_ServiceDesc is the grpc.ServiceDesc for Favorite service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Favorite_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "favorite.Favorite",
+	HandlerType: (*FavoriteServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFavorite",
+			Handler:    _Favorite_CreateFavorite_Handler,
+		},
+		{
+			MethodName: "DeleteFavorite",
+			Handler:    _Favorite_DeleteFavorite_Handler,
+		},
+		{
+			MethodName: "GetAllFavorites",
+			Handler:    _Favorite_GetAllFavorites_Handler,
+		},
+		{
+			MethodName: "IsFavorite",
+			Handler:    _Favorite_IsFavorite_Handler,
+		},
+		{
+			MethodName: "CreateFavorites",
+			Handler:    _Favorite_CreateFavorites_Handler,
+		},
+		{
+			MethodName: "DeleteFavorites",
+			Handler:    _Favorite_DeleteFavorites_Handler,
+		},
+		{
+			MethodName: "GetFileFavoriters",
+			Handler:    _Favorite_GetFileFavoriters_Handler,
+		},
+		{
+			MethodName: "CountFileFavorites",
+			Handler:    _Favorite_CountFileFavorites_Handler,
+		},
+		{
+			MethodName: "DeleteFavoritesByFile",
+			Handler:    _Favorite_DeleteFavoritesByFile_Handler,
+		},
+		{
+			MethodName: "DeleteFavoritesByFiles",
+			Handler:    _Favorite_DeleteFavoritesByFiles_Handler,
+		},
+		{
+			MethodName: "DeleteAllUserFavorites",
+			Handler:    _Favorite_DeleteAllUserFavorites_Handler,
+		},
+		{
+			MethodName: "TransferFavorites",
+			Handler:    _Favorite_TransferFavorites_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _Favorite_CreateCollection_Handler,
+		},
+		{
+			MethodName: "GetCollections",
+			Handler:    _Favorite_GetCollections_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _Favorite_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _Favorite_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "AddToCollections",
+			Handler:    _Favorite_AddToCollections_Handler,
+		},
+		{
+			MethodName: "RemoveFromCollections",
+			Handler:    _Favorite_RemoveFromCollections_Handler,
+		},
+		{
+			MethodName: "UpdateFavorite",
+			Handler:    _Favorite_UpdateFavorite_Handler,
+		},
+		{
+			MethodName: "ReorderFavorites",
+			Handler:    _Favorite_ReorderFavorites_Handler,
+		},
+		{
+			MethodName: "RestoreFavorite",
+			Handler:    _Favorite_RestoreFavorite_Handler,
+		},
+		{
+			MethodName: "ListRecentlyDeletedFavorites",
+			Handler:    _Favorite_ListRecentlyDeletedFavorites_Handler,
+		},
+		{
+			MethodName: "GetFavoriteQuota",
+			Handler:    _Favorite_GetFavoriteQuota_Handler,
+		},
+		{
+			MethodName: "SetFavoriteQuota",
+			Handler:    _Favorite_SetFavoriteQuota_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListFavorites",
+			Handler:       _Favorite_ListFavorites_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchFavorites",
+			Handler:       _Favorite_WatchFavorites_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/fav.proto",
 }
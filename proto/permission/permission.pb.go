@@ -0,0 +1,293 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.15.7
+// source: proto/permission/permission.proto
+
+package permission
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Role int32
+
+const (
+	Role_NONE  Role = 0
+	Role_WRITE Role = 1
+	Role_READ  Role = 2
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "NONE",
+		1: "WRITE",
+		2: "READ",
+	}
+	Role_value = map[string]int32{
+		"NONE":  0,
+		"WRITE": 1,
+		"READ":  2,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_permission_permission_proto_enumTypes[0].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_proto_permission_permission_proto_enumTypes[0]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_proto_permission_permission_proto_rawDescGZIP(), []int{0}
+}
+
+type IsPermittedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileID string `protobuf:"bytes,1,opt,name=fileID,proto3" json:"fileID,omitempty"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Role   Role   `protobuf:"varint,3,opt,name=role,proto3,enum=permission.Role" json:"role,omitempty"`
+}
+
+func (x *IsPermittedRequest) Reset() {
+	*x = IsPermittedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_permission_permission_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsPermittedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsPermittedRequest) ProtoMessage() {}
+
+func (x *IsPermittedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_permission_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsPermittedRequest.ProtoReflect.Descriptor instead.
+func (*IsPermittedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_permission_permission_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IsPermittedRequest) GetFileID() string {
+	if x != nil {
+		return x.FileID
+	}
+	return ""
+}
+
+func (x *IsPermittedRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *IsPermittedRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_NONE
+}
+
+type IsPermittedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Permitted bool `protobuf:"varint,1,opt,name=permitted,proto3" json:"permitted,omitempty"`
+}
+
+func (x *IsPermittedResponse) Reset() {
+	*x = IsPermittedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_permission_permission_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsPermittedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsPermittedResponse) ProtoMessage() {}
+
+func (x *IsPermittedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_permission_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsPermittedResponse.ProtoReflect.Descriptor instead.
+func (*IsPermittedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_permission_permission_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IsPermittedResponse) GetPermitted() bool {
+	if x != nil {
+		return x.Permitted
+	}
+	return false
+}
+
+var File_proto_permission_permission_proto protoreflect.FileDescriptor
+
+var file_proto_permission_permission_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x6a, 0x0a, 0x12, 0x49, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x33, 0x0a, 0x13, 0x49,
+	0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x2a, 0x25, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x02, 0x32, 0x5e, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x0b, 0x49, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x65, 0x61, 0x74, 0x65, 0x61, 0x6d, 0x2f, 0x66, 0x61,
+	0x76, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_proto_permission_permission_proto_rawDescOnce sync.Once
+	file_proto_permission_permission_proto_rawDescData = file_proto_permission_permission_proto_rawDesc
+)
+
+func file_proto_permission_permission_proto_rawDescGZIP() []byte {
+	file_proto_permission_permission_proto_rawDescOnce.Do(func() {
+		file_proto_permission_permission_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_permission_permission_proto_rawDescData)
+	})
+	return file_proto_permission_permission_proto_rawDescData
+}
+
+var file_proto_permission_permission_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_permission_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_permission_permission_proto_goTypes = []interface{}{
+	(Role)(0),                   // 0: permission.Role
+	(*IsPermittedRequest)(nil),  // 1: permission.IsPermittedRequest
+	(*IsPermittedResponse)(nil), // 2: permission.IsPermittedResponse
+}
+var file_proto_permission_permission_proto_depIdxs = []int32{
+	0, // 0: permission.IsPermittedRequest.role:type_name -> permission.Role
+	1, // 1: permission.Permission.IsPermitted:input_type -> permission.IsPermittedRequest
+	2, // 2: permission.Permission.IsPermitted:output_type -> permission.IsPermittedResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_permission_permission_proto_init() }
+func file_proto_permission_permission_proto_init() {
+	if File_proto_permission_permission_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_permission_permission_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsPermittedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_permission_permission_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsPermittedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_permission_permission_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_permission_permission_proto_goTypes,
+		DependencyIndexes: file_proto_permission_permission_proto_depIdxs,
+		EnumInfos:         file_proto_permission_permission_proto_enumTypes,
+		MessageInfos:      file_proto_permission_permission_proto_msgTypes,
+	}.Build()
+	File_proto_permission_permission_proto = out.File
+	file_proto_permission_permission_proto_rawDesc = nil
+	file_proto_permission_permission_proto_goTypes = nil
+	file_proto_permission_permission_proto_depIdxs = nil
+}
@@ -2,9 +2,11 @@ package server
 
 import (
 	"context"
+	"expvar"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"strings"
 	"time"
 
@@ -12,7 +14,12 @@ import (
 	ilogger "github.com/meateam/elasticsearch-logger"
 	pb "github.com/meateam/fav-service/proto"
 	"github.com/meateam/fav-service/service"
+	"github.com/meateam/fav-service/service/authorizer"
+	"github.com/meateam/fav-service/service/checker"
+	"github.com/meateam/fav-service/service/consumer"
+	"github.com/meateam/fav-service/service/memory"
 	"github.com/meateam/fav-service/service/mongodb"
+	"github.com/meateam/fav-service/service/publisher"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"go.elastic.co/apm/module/apmmongo"
@@ -26,15 +33,52 @@ import (
 )
 
 const (
-	configPort                  		= "port"
-	envPrefix 							= "FVS"
-	configHealthCheckInterval          	= "health_check_interval"
-	configMongoConnectionString 		= "mongo_host"
-	configMongoClientConnectionTimeout 	= "mongo_client_connection_timeout"
-	configMongoClientPingTimeout       	= "mongo_client_ping_timeout"
-	configElasticAPMIgnoreURLS         	= "elastic_apm_ignore_urls"
-
-
+	configPort                         = "port"
+	envPrefix                          = "FVS"
+	configHealthCheckInterval          = "health_check_interval"
+	configMongoConnectionString        = "mongo_host"
+	configMongoClientConnectionTimeout = "mongo_client_connection_timeout"
+	configMongoClientPingTimeout       = "mongo_client_ping_timeout"
+	configElasticAPMIgnoreURLS         = "elastic_apm_ignore_urls"
+	configStore                        = "store"
+	configRestoreWindow                = "restore_window"
+	configPurgeInterval                = "purge_interval"
+	configFavoriteQuota                = "favorite_quota"
+	configEventPublisher               = "event_publisher"
+	configEventFile                    = "event_file"
+	configRelayInterval                = "relay_interval"
+	configFileEventConsumer            = "file_event_consumer"
+	configFileEventFile                = "file_event_file"
+	configFileEventInterval            = "file_event_interval"
+	configAuthorizer                   = "authorizer"
+	configPermissionService            = "permission_service"
+	configFilterFavorites              = "filter_favorites"
+	configReconcileInterval            = "reconcile_interval"
+	configReconcileAction              = "reconcile_action"
+	configReconcileDryRun              = "reconcile_dry_run"
+	configReconcileBatchSize           = "reconcile_batch_size"
+	configReconcileBatchDelay          = "reconcile_batch_delay"
+	configFileService                  = "file_service"
+	configMetricsPort                  = "metrics_port"
+
+	// storeMongoDB is the configStore value for storing favorites in mongodb.
+	storeMongoDB = "mongodb"
+
+	// storeMemory is the configStore value for storing favorites in memory,
+	// favorites are lost when the server stops.
+	storeMemory = "memory"
+
+	// publisherFile is the configEventPublisher value for publishing the events of favorite mutations
+	// to the file of configEventFile, for running the service locally.
+	publisherFile = "file"
+
+	// consumerFile is the configFileEventConsumer value for consuming the events of the lifecycle of files
+	// from the file of configFileEventFile, for running the service locally.
+	consumerFile = "file"
+
+	// authorizerPermission is the configAuthorizer value for checking whether users may access files
+	// with the permission service of configPermissionService.
+	authorizerPermission = "permission"
 )
 
 func init() {
@@ -44,20 +88,39 @@ func init() {
 	viper.SetDefault(configMongoConnectionString, "mongodb://mongo:27017/favorite")
 	viper.SetDefault(configMongoClientConnectionTimeout, 10)
 	viper.SetDefault(configMongoClientPingTimeout, 10)
+	viper.SetDefault(configStore, storeMongoDB)
+	viper.SetDefault(configRestoreWindow, 7*24*60*60)
+	viper.SetDefault(configPurgeInterval, 60*60)
+	viper.SetDefault(configFavoriteQuota, 10000)
+	viper.SetDefault(configEventFile, "favorite-events.jsonl")
+	viper.SetDefault(configRelayInterval, 1)
+	viper.SetDefault(configFileEventFile, "file-events.jsonl")
+	viper.SetDefault(configFileEventInterval, 1)
+	viper.SetDefault(configPermissionService, "permission-service:8080")
+	viper.SetDefault(configFilterFavorites, false)
+	viper.SetDefault(configReconcileInterval, 0)
+	viper.SetDefault(configReconcileAction, service.ReconcileFlag)
+	viper.SetDefault(configReconcileDryRun, false)
+	viper.SetDefault(configReconcileBatchSize, service.DefaultReconcileBatchSize)
+	viper.SetDefault(configReconcileBatchDelay, 100)
+	viper.SetDefault(configFileService, "file-service:8080")
 	viper.SetEnvPrefix(envPrefix)
 	viper.AutomaticEnv()
 }
 
-// FavoriteServer is a structure that holds the permission grpc server and its services and configuration. 
+// FavoriteServer is a structure that holds the permission grpc server and its services and configuration.
 type FavoriteServer struct {
 	*grpc.Server
-	logger 		*logrus.Logger
-	port 		string
+	logger              *logrus.Logger
+	port                string
 	healthCheckInterval int
-	favoriteService service.Service
+	purgeInterval       int
+	relayInterval       int
+	fileEventInterval   int
+	reconcileInterval   int
+	favoriteService     service.Service
 }
 
-
 // Serve accepts incoming connections on the listener `lis`, creating a new
 // ServerTransport and service goroutine for each. The service goroutines
 // read gRPC requests and then call the registered handlers to reply to them.
@@ -68,7 +131,7 @@ type FavoriteServer struct {
 // Serve will return a non-nil error unless Stop or GracefulStop is called.
 func (s FavoriteServer) Serve(lis net.Listener) {
 	listener := lis
-	if lis ==nil {
+	if lis == nil {
 		l, err := net.Listen("tcp", ":"+s.port)
 		if err != nil {
 			s.logger.Fatalf("failed to listen: %v", err)
@@ -84,54 +147,154 @@ func (s FavoriteServer) Serve(lis net.Listener) {
 
 }
 
-
 // NewServer configures and creates a grpc.Server instance
 // health check service.
 // Configure using environment variables.
 // `HEALTH_CHECK_INTERVAL`: Interval to update serving state of the health check server.
 // `PORT`: TCP port on which the grpc server would serve on.
+// `STORE`: The store backend of the favorites, either "mongodb" (default) or "memory".
+// `RESTORE_WINDOW`: Seconds after deleting a favorite during which it may be restored.
+// `PURGE_INTERVAL`: Interval in seconds of purging the favorites deleted before the restore window.
+// `FAVORITE_QUOTA`: The maximum number of favorites of a user without a quota override, 0 if unlimited.
+// `EVENT_PUBLISHER`: The publisher of the events of favorite mutations, either "file" or empty (default) for none.
+// Publishing events with the "mongodb" store requires a replica set or a sharded cluster.
+// `EVENT_FILE`: The file that the "file" publisher appends the events to.
+// `RELAY_INTERVAL`: Interval in seconds of publishing the events of favorite mutations from the outbox.
+// `FILE_EVENT_CONSUMER`: The consumer of the events of the lifecycle of files, either "file" or empty (default) for none.
+// `FILE_EVENT_FILE`: The file that the "file" consumer reads the events from.
+// `FILE_EVENT_INTERVAL`: Interval in seconds of checking for new file events and of retrying a failed file event.
+// `AUTHORIZER`: The authorizer of favoriting items, either "permission" or empty (default) for allowing all access.
+// `PERMISSION_SERVICE`: The address of the permission service used by the "permission" authorizer.
+// `FILTER_FAVORITES`: Whether to leave the favorites of items users may no longer access out of GetAllFavorites.
+// `RECONCILE_INTERVAL`: Interval in seconds of reconciling the favorites of files that don't exist, 0 (default) to disable.
+// `RECONCILE_ACTION`: The action taken on the favorites of files that don't exist, either "flag" (default) or "delete".
+// `RECONCILE_DRY_RUN`: Whether to only report the favorites of files that don't exist without taking the action.
+// `RECONCILE_BATCH_SIZE`: The number of favorites checked in each batch of a reconciliation.
+// `RECONCILE_BATCH_DELAY`: Milliseconds to wait between the batches of a reconciliation.
+// `FILE_SERVICE`: The address of the file service used to check whether files exist.
+// `METRICS_PORT`: TCP port on which the metrics are served at /debug/vars, empty (default) to disable.
 func NewServer(logger *logrus.Logger) *FavoriteServer {
 	if logger == nil {
 		logger = ilogger.NewLogger()
 	}
 
-	// Set up grpc server opts with logger interceptor.
+	// Set up grpc server opts with logger interceptor, and error interceptors
+	// that convert the service errors into grpc status errors.
 	serverOpts := append(
 		serverLoggerInterceptor(logger),
 		grpc.MaxRecvMsgSize(16<<20),
+		grpc.ChainUnaryInterceptor(service.UnaryErrorInterceptor(logger)),
+		grpc.ChainStreamInterceptor(service.StreamErrorInterceptor(logger)),
 	)
 
 	grpcServer := grpc.NewServer(
 		serverOpts...,
 	)
 
-	controller, err := initMongoDBController()
+	controller, err := initController()
+	if err != nil {
+		logger.Fatalf("%v", err)
+	}
+
+	favoriteAuthorizer, err := initAuthorizer()
 	if err != nil {
 		logger.Fatalf("%v", err)
 	}
 
-	favoriteService := service.NewService(controller, logger)
+	favoriteService := service.NewService(controller, favoriteAuthorizer, viper.GetBool(configFilterFavorites), logger)
 	pb.RegisterFavoriteServer(grpcServer, favoriteService)
 
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 
-
 	favoriteServer := &FavoriteServer{
-		Server: grpcServer,
-		logger: logger,
-		port: viper.GetString(configPort),
+		Server:              grpcServer,
+		logger:              logger,
+		port:                viper.GetString(configPort),
 		healthCheckInterval: viper.GetInt(configHealthCheckInterval),
-		favoriteService: favoriteService,
+		purgeInterval:       viper.GetInt(configPurgeInterval),
+		relayInterval:       viper.GetInt(configRelayInterval),
+		fileEventInterval:   viper.GetInt(configFileEventInterval),
+		reconcileInterval:   viper.GetInt(configReconcileInterval),
+		favoriteService:     favoriteService,
 	}
 
 	// Health check validation goroutine worker.
 	go favoriteServer.healthCheckWorker(healthServer)
 
+	// Deleted favorites purge goroutine worker.
+	go favoriteServer.purgeWorker()
+
+	// Favorite mutation events relay goroutine worker.
+	eventPublisher, err := initPublisher()
+	if err != nil {
+		logger.Fatalf("%v", err)
+	}
+
+	if eventPublisher != nil {
+		go favoriteServer.relayWorker(eventPublisher)
+	}
+
+	// File lifecycle events consumer goroutine worker.
+	fileEventConsumer, err := initConsumer(time.Second * time.Duration(favoriteServer.fileEventInterval))
+	if err != nil {
+		logger.Fatalf("%v", err)
+	}
+
+	if fileEventConsumer != nil {
+		go favoriteServer.fileEventWorker(fileEventConsumer)
+	}
+
+	// Orphaned favorites reconciliation goroutine worker.
+	if favoriteServer.reconcileInterval > 0 {
+		fileChecker, reconcileOptions, err := initReconcile()
+		if err != nil {
+			logger.Fatalf("%v", err)
+		}
+
+		go favoriteServer.reconcileWorker(fileChecker, reconcileOptions)
+	}
+
+	// Metrics goroutine server.
+	if metricsPort := viper.GetString(configMetricsPort); metricsPort != "" {
+		go favoriteServer.serveMetrics(metricsPort)
+	}
+
 	return favoriteServer
 
 }
 
+// initController creates the controller of the configured store backend.
+func initController() (service.Controller, error) {
+	switch store := viper.GetString(configStore); store {
+	case storeMongoDB:
+		return initMongoDBController()
+	case storeMemory:
+		return memory.NewMemoryController(
+			viper.GetDuration(configRestoreWindow)*time.Second,
+			viper.GetInt64(configFavoriteQuota),
+			viper.GetString(configEventPublisher) != "",
+		)
+	default:
+		return nil, fmt.Errorf("unknown store %q, must be one of %q or %q", store, storeMongoDB, storeMemory)
+	}
+
+}
+
+// initPublisher creates the configured publisher of the events of favorite mutations,
+// returns nil if events aren't published.
+func initPublisher() (service.EventPublisher, error) {
+	switch eventPublisher := viper.GetString(configEventPublisher); eventPublisher {
+	case "":
+		return nil, nil
+	case publisherFile:
+		return publisher.NewFilePublisher(viper.GetString(configEventFile))
+	default:
+		return nil, fmt.Errorf("unknown event publisher %q, must be %q or empty", eventPublisher, publisherFile)
+	}
+
+}
+
 func initMongoDBController() (service.Controller, error) {
 	mongoClient, err := connectToMongoDB(viper.GetString(configMongoConnectionString))
 	if err != nil {
@@ -143,7 +306,12 @@ func initMongoDBController() (service.Controller, error) {
 		return nil, err
 	}
 
-	controller, err := mongodb.NewMongoController(db)
+	controller, err := mongodb.NewMongoController(
+		db,
+		viper.GetDuration(configRestoreWindow)*time.Second,
+		viper.GetInt64(configFavoriteQuota),
+		viper.GetString(configEventPublisher) != "",
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed creating mongo store: %v", err)
 	}
@@ -186,7 +354,6 @@ func connectToMongoDB(connectionString string) (*mongo.Client, error) {
 
 }
 
-
 func getMongoDatabaseName(mongoClient *mongo.Client, connectionString string) (*mongo.Database, error) {
 	connString, err := connstring.Parse(connectionString)
 	if err != nil {
@@ -197,7 +364,6 @@ func getMongoDatabaseName(mongoClient *mongo.Client, connectionString string) (*
 
 }
 
-
 func serverLoggerInterceptor(logger *logrus.Logger) []grpc.ServerOption {
 	// Create new logrus entry for logger interceptor.
 	logrusEntry := logrus.NewEntry(logger)
@@ -210,7 +376,7 @@ func serverLoggerInterceptor(logger *logrus.Logger) []grpc.ServerOption {
 		strings.Split(viper.GetString(configElasticAPMIgnoreURLS), ",")...,
 	)
 
-	loggerOpts := []grpc_logrus.Option {
+	loggerOpts := []grpc_logrus.Option{
 		grpc_logrus.WithDecider(func(fullMethodName string, err error) bool {
 			return ignorePayload(fullMethodName)
 		}),
@@ -226,8 +392,6 @@ func serverLoggerInterceptor(logger *logrus.Logger) []grpc.ServerOption {
 
 }
 
-
-
 // healthCheckWorker is running an infinite loop that sets the serving status once
 // in s.healthCheckInterval seconds.
 func (s FavoriteServer) healthCheckWorker(healthServer *health.Server) {
@@ -247,5 +411,193 @@ func (s FavoriteServer) healthCheckWorker(healthServer *health.Server) {
 
 }
 
+// initAuthorizer creates the configured authorizer of favoriting items.
+func initAuthorizer() (service.Authorizer, error) {
+	switch configuredAuthorizer := viper.GetString(configAuthorizer); configuredAuthorizer {
+	case "":
+		return authorizer.AllowAllAuthorizer{}, nil
+	case authorizerPermission:
+		conn, err := grpc.Dial(viper.GetString(configPermissionService), grpc.WithInsecure())
+		if err != nil {
+			return nil, fmt.Errorf("failed connecting to permission service: %v", err)
+		}
+
+		return authorizer.NewPermissionAuthorizer(conn), nil
+	default:
+		return nil, fmt.Errorf("unknown authorizer %q, must be %q or empty", configuredAuthorizer, authorizerPermission)
+	}
+
+}
+
+// initReconcile creates the checker of whether files exist with the file service and the configured
+// options of reconciling the favorites of files that don't exist.
+func initReconcile() (service.FileExistenceChecker, service.ReconcileOptions, error) {
+	opts := service.ReconcileOptions{
+		Action:     viper.GetString(configReconcileAction),
+		DryRun:     viper.GetBool(configReconcileDryRun),
+		BatchSize:  viper.GetInt(configReconcileBatchSize),
+		BatchDelay: viper.GetDuration(configReconcileBatchDelay) * time.Millisecond,
+	}
 
+	if opts.Action != service.ReconcileDelete && opts.Action != service.ReconcileFlag {
+		return nil, service.ReconcileOptions{}, fmt.Errorf(
+			"unknown reconcile action %q, must be %q or %q", opts.Action, service.ReconcileDelete, service.ReconcileFlag,
+		)
+	}
 
+	conn, err := grpc.Dial(viper.GetString(configFileService), grpc.WithInsecure())
+	if err != nil {
+		return nil, service.ReconcileOptions{}, fmt.Errorf("failed connecting to file service: %v", err)
+	}
+
+	return checker.NewFileServiceChecker(conn), opts, nil
+
+}
+
+// initConsumer creates the configured consumer of the events of the lifecycle of files,
+// which checks for new events once in pollInterval, returns nil if file events aren't consumed.
+func initConsumer(pollInterval time.Duration) (service.FileEventConsumer, error) {
+	switch fileEventConsumer := viper.GetString(configFileEventConsumer); fileEventConsumer {
+	case "":
+		return nil, nil
+	case consumerFile:
+		return consumer.NewFileConsumer(viper.GetString(configFileEventFile), pollInterval), nil
+	default:
+		return nil, fmt.Errorf("unknown file event consumer %q, must be %q or empty", fileEventConsumer, consumerFile)
+	}
+
+}
+
+// purgeWorker is running an infinite loop that deletes the expired favorites and purges the favorites
+// deleted before the restore window once in s.purgeInterval seconds.
+func (s FavoriteServer) purgeWorker() {
+	for {
+		expiredCount, err := s.favoriteService.ExpireFavorites(context.Background())
+		if err != nil {
+			s.logger.Errorf("failed expiring favorites: %v", err)
+		} else if expiredCount > 0 {
+			s.logger.Infof("expired %d favorites", expiredCount)
+		}
+
+		purgedCount, err := s.favoriteService.PurgeDeletedFavorites(context.Background())
+		if err != nil {
+			s.logger.Errorf("failed purging deleted favorites: %v", err)
+		} else if purgedCount > 0 {
+			s.logger.Infof("purged %d deleted favorites", purgedCount)
+		}
+
+		time.Sleep(time.Second * time.Duration(s.purgeInterval))
+	}
+
+}
+
+// relayWorker is running an infinite loop that publishes the events of favorite mutations with publisher,
+// waiting s.relayInterval seconds whenever there are no more events to publish or publishing fails.
+func (s FavoriteServer) relayWorker(publisher service.EventPublisher) {
+	for {
+		publishedCount, err := s.favoriteService.RelayEvents(context.Background(), publisher)
+		if err != nil {
+			s.logger.Errorf("failed relaying events: %v", err)
+		}
+
+		if err == nil && publishedCount == service.RelayBatchSize {
+			continue
+		}
+
+		time.Sleep(time.Second * time.Duration(s.relayInterval))
+	}
+
+}
+
+// fileEventWorker is running an infinite loop that applies the events of the lifecycle of files consumed
+// with fileEventConsumer to their favorites, waiting s.fileEventInterval seconds before retrying a failed event.
+func (s FavoriteServer) fileEventWorker(fileEventConsumer service.FileEventConsumer) {
+	for {
+		err := fileEventConsumer.Consume(context.Background(), func(ctx context.Context, event service.FileEvent) error {
+			affectedCount, err := s.favoriteService.HandleFileEvent(ctx, event)
+			if err != nil {
+				return fmt.Errorf("failed handling %s event of file %s: %w", event.Type, event.FileID, err)
+			}
+
+			if affectedCount > 0 {
+				s.logger.Infof("handled %s event of file %s affecting %d favorites", event.Type, event.FileID, affectedCount)
+			}
+
+			return nil
+		})
+		if err != nil {
+			s.logger.Errorf("failed consuming file events: %v", err)
+		}
+
+		time.Sleep(time.Second * time.Duration(s.fileEventInterval))
+	}
+
+}
+
+// reconcileMetrics exposes the totals of the reconciliations of the favorites of files that don't exist.
+var reconcileMetrics = expvar.NewMap("orphanReconciliation")
+
+// reconcileWorker is running an infinite loop that reconciles the favorites of files that don't exist
+// as checked by fileChecker with opts once in s.reconcileInterval seconds, logging the report of each
+// reconciliation and adding it to the metrics.
+func (s FavoriteServer) reconcileWorker(fileChecker service.FileExistenceChecker, opts service.ReconcileOptions) {
+	for {
+		report, err := s.favoriteService.ReconcileOrphans(context.Background(), fileChecker, opts)
+		recordReconcileMetrics(report, err)
+
+		entry := s.logger.WithFields(logrus.Fields{
+			"action":              report.Action,
+			"dryRun":              report.DryRun,
+			"batches":             report.Batches,
+			"scannedFavorites":    report.ScannedFavorites,
+			"checkedFiles":        report.CheckedFiles,
+			"orphanedFiles":       report.OrphanedFiles,
+			"orphanedFavorites":   report.OrphanedFavorites,
+			"reconciledFavorites": report.ReconciledFavorites,
+			"unflaggedFavorites":  report.UnflaggedFavorites,
+			"duration":            report.Duration.String(),
+		})
+		if err != nil {
+			entry.Errorf("failed reconciling orphaned favorites: %v", err)
+		} else {
+			entry.Info("reconciled orphaned favorites")
+		}
+
+		time.Sleep(time.Second * time.Duration(s.reconcileInterval))
+	}
+
+}
+
+// recordReconcileMetrics adds report, the report of a reconciliation that failed with err if it's non-nil,
+// to reconcileMetrics.
+func recordReconcileMetrics(report service.ReconcileReport, err error) {
+	reconcileMetrics.Add("runs", 1)
+	if err != nil {
+		reconcileMetrics.Add("failedRuns", 1)
+	}
+
+	reconcileMetrics.Add("scannedFavorites", report.ScannedFavorites)
+	reconcileMetrics.Add("checkedFiles", report.CheckedFiles)
+	reconcileMetrics.Add("orphanedFiles", report.OrphanedFiles)
+	reconcileMetrics.Add("orphanedFavorites", report.OrphanedFavorites)
+	reconcileMetrics.Add("reconciledFavorites", report.ReconciledFavorites)
+	reconcileMetrics.Add("unflaggedFavorites", report.UnflaggedFavorites)
+
+	lastDuration := new(expvar.Int)
+	lastDuration.Set(int64(report.Duration / time.Millisecond))
+	reconcileMetrics.Set("lastDurationMillis", lastDuration)
+
+	lastOrphanedFavorites := new(expvar.Int)
+	lastOrphanedFavorites.Set(report.OrphanedFavorites)
+	reconcileMetrics.Set("lastOrphanedFavorites", lastOrphanedFavorites)
+
+}
+
+// serveMetrics serves the expvar metrics at /debug/vars on port.
+func (s FavoriteServer) serveMetrics(port string) {
+	log.Printf("serving metrics on port %s", port)
+	if err := http.ListenAndServe(":"+port, nil); err != nil {
+		s.logger.Errorf("failed serving metrics: %v", err)
+	}
+
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// AuditActionDeleteAllUserFavorites is the action of deleting all of the favorites of a user.
+const AuditActionDeleteAllUserFavorites = "DELETE_ALL_USER_FAVORITES"
+
+// AuditRecord is a record of an operation that must be kept for compliance,
+// such as erasing the favorites of a user.
+type AuditRecord struct {
+	Action       string
+	UserID       string
+	Reason       string
+	RequestedBy  string
+	DeletedCount int64
+	CreatedAt    time.Time
+}
+
+// AuditStore is an interface for storing audit records.
+type AuditStore interface {
+	// Create stores record.
+	Create(ctx context.Context, record AuditRecord) error
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+)
+
+// Authorizer is an interface for checking whether users may access the items they favorite.
+type Authorizer interface {
+	// Authorize returns whether userID may access each of the items of itemType and itemIDs, in their order.
+	Authorize(ctx context.Context, userID string, itemType string, itemIDs []string) ([]bool, error)
+}
@@ -0,0 +1,172 @@
+// Package authorizer implements service.Authorizer, checking whether users may access files with
+// the permission service, or with a static table or allowing all access for running the service
+// locally and in tests.
+package authorizer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	ppb "github.com/meateam/fav-service/proto/permission"
+	"github.com/meateam/fav-service/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxConcurrentChecks is the maximum number of concurrent requests to the permission service
+// made by a single call to PermissionAuthorizer.Authorize.
+const MaxConcurrentChecks = 16
+
+// AllowAllAuthorizer must implement service.Authorizer.
+var _ service.Authorizer = AllowAllAuthorizer{}
+
+// AllowAllAuthorizer allows all users to access all items.
+type AllowAllAuthorizer struct{}
+
+// Authorize returns true for each of itemIDs.
+func (a AllowAllAuthorizer) Authorize(ctx context.Context, userID string, itemType string, itemIDs []string) ([]bool, error) {
+	authorized := make([]bool, len(itemIDs))
+	for i := range authorized {
+		authorized[i] = true
+	}
+
+	return authorized, nil
+
+}
+
+// StaticAuthorizer must implement service.Authorizer.
+var _ service.Authorizer = (*StaticAuthorizer)(nil)
+
+// staticItem is an item that a user may access in the table of a StaticAuthorizer.
+type staticItem struct {
+	userID   string
+	itemType string
+	itemID   string
+}
+
+// StaticAuthorizer allows users to access the items in its table and denies the rest.
+type StaticAuthorizer struct {
+	mu      sync.RWMutex
+	allowed map[staticItem]bool
+}
+
+// NewStaticAuthorizer returns a new StaticAuthorizer that denies all access until it's allowed.
+func NewStaticAuthorizer() *StaticAuthorizer {
+	return &StaticAuthorizer{allowed: make(map[staticItem]bool)}
+
+}
+
+// Allow allows userID to access the items of itemType and itemIDs.
+func (a *StaticAuthorizer) Allow(userID string, itemType string, itemIDs ...string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, itemID := range itemIDs {
+		a.allowed[staticItem{userID: userID, itemType: itemType, itemID: itemID}] = true
+	}
+
+}
+
+// Deny denies userID from accessing the items of itemType and itemIDs.
+func (a *StaticAuthorizer) Deny(userID string, itemType string, itemIDs ...string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, itemID := range itemIDs {
+		delete(a.allowed, staticItem{userID: userID, itemType: itemType, itemID: itemID})
+	}
+
+}
+
+// Authorize returns whether each of the items of itemType and itemIDs is allowed for userID.
+func (a *StaticAuthorizer) Authorize(ctx context.Context, userID string, itemType string, itemIDs []string) ([]bool, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	authorized := make([]bool, len(itemIDs))
+	for i, itemID := range itemIDs {
+		authorized[i] = a.allowed[staticItem{userID: userID, itemType: itemType, itemID: itemID}]
+	}
+
+	return authorized, nil
+
+}
+
+// PermissionAuthorizer must implement service.Authorizer.
+var _ service.Authorizer = PermissionAuthorizer{}
+
+// PermissionAuthorizer checks whether users may read files with the permission service.
+// The permission service only holds the permissions of files, so items of other types are allowed.
+type PermissionAuthorizer struct {
+	client ppb.PermissionClient
+}
+
+// NewPermissionAuthorizer returns a new PermissionAuthorizer using the permission service of conn.
+func NewPermissionAuthorizer(conn *grpc.ClientConn) PermissionAuthorizer {
+	return PermissionAuthorizer{client: ppb.NewPermissionClient(conn)}
+
+}
+
+// Authorize returns whether userID may read each of the files of itemIDs if itemType is a file,
+// checking up to MaxConcurrentChecks files at a time. Returns the first error of checking a file.
+func (a PermissionAuthorizer) Authorize(ctx context.Context, userID string, itemType string, itemIDs []string) ([]bool, error) {
+	if itemType != service.ItemTypeFile {
+		return AllowAllAuthorizer{}.Authorize(ctx, userID, itemType, itemIDs)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	authorized := make([]bool, len(itemIDs))
+	checks := make(chan struct{}, MaxConcurrentChecks)
+
+	// firstErr is the error of the first failed check, which cancels the rest.
+	var firstErr error
+	var once sync.Once
+	var wg sync.WaitGroup
+	for i, itemID := range itemIDs {
+		wg.Add(1)
+		checks <- struct{}{}
+		go func(i int, itemID string) {
+			defer wg.Done()
+			defer func() { <-checks }()
+
+			response, err := a.client.IsPermitted(ctx, &ppb.IsPermittedRequest{FileID: itemID, UserID: userID, Role: ppb.Role_READ})
+			if err != nil {
+				once.Do(func() {
+					firstErr = toAuthorizerError(err, itemID)
+					cancel()
+				})
+				return
+			}
+
+			authorized[i] = response.GetPermitted()
+		}(i, itemID)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return authorized, nil
+
+}
+
+// toAuthorizerError returns the error of checking the permission of fileID as a service error.
+func toAuthorizerError(err error, fileID string) error {
+	code := status.Code(err)
+	err = fmt.Errorf("failed checking permission of file %s: %w", fileID, err)
+	switch code {
+	case codes.Unavailable:
+		return service.NewAuthorizerUnavailableError(err)
+	case codes.DeadlineExceeded:
+		return service.NewDeadlineError(err)
+	}
+
+	return err
+
+}
@@ -0,0 +1,56 @@
+package authorizer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/meateam/fav-service/service"
+)
+
+// assertAuthorized fails t unless a authorizes userID to access the items of itemType and itemIDs
+// as in want.
+func assertAuthorized(t *testing.T, a service.Authorizer, userID string, itemType string, itemIDs []string, want ...bool) {
+	t.Helper()
+
+	authorized, err := a.Authorize(context.Background(), userID, itemType, itemIDs)
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+
+	if len(authorized) != len(want) {
+		t.Fatalf("Authorize() = %v, want %v", authorized, want)
+	}
+
+	for i := range want {
+		if authorized[i] != want[i] {
+			t.Errorf("Authorize() = %v, want %v", authorized, want)
+			return
+		}
+	}
+
+}
+
+func TestStaticAuthorizer(t *testing.T) {
+	a := NewStaticAuthorizer()
+	itemIDs := []string{"file1", "file2", "file3"}
+
+	assertAuthorized(t, a, "user", service.ItemTypeFile, itemIDs, false, false, false)
+
+	a.Allow("user", service.ItemTypeFile, "file1", "file3")
+	assertAuthorized(t, a, "user", service.ItemTypeFile, itemIDs, true, false, true)
+
+	// Allowing is per user and per item type.
+	assertAuthorized(t, a, "other", service.ItemTypeFile, itemIDs, false, false, false)
+	assertAuthorized(t, a, "user", service.ItemTypeFolder, itemIDs, false, false, false)
+
+	a.Deny("user", service.ItemTypeFile, "file1")
+	assertAuthorized(t, a, "user", service.ItemTypeFile, itemIDs, false, false, true)
+
+	assertAuthorized(t, a, "user", service.ItemTypeFile, nil)
+
+}
+
+func TestAllowAllAuthorizer(t *testing.T) {
+	assertAuthorized(t, AllowAllAuthorizer{}, "user", service.ItemTypeFile, []string{"file1", "file2"}, true, true)
+
+}
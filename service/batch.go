@@ -0,0 +1,69 @@
+package service
+
+import (
+	pb "github.com/meateam/fav-service/proto"
+)
+
+// BatchStatus is the outcome of a single item of a batch operation.
+type BatchStatus int
+
+const (
+	// BatchFailed means the operation failed for the item, see BatchResult.Err.
+	BatchFailed BatchStatus = iota
+
+	// BatchCreated means the favorite of the item was created.
+	BatchCreated
+
+	// BatchAlreadyExists means the favorite of the item already existed.
+	BatchAlreadyExists
+
+	// BatchDeleted means the favorite of the item was deleted.
+	BatchDeleted
+
+	// BatchNotFound means there was no favorite of the item.
+	BatchNotFound
+
+	// BatchUpdated means the favorite of the item was updated.
+	BatchUpdated
+
+	// BatchPermissionDenied means the user may not access the item.
+	BatchPermissionDenied
+)
+
+// batchStatuses maps a BatchStatus to its pb.BatchStatus.
+var batchStatuses = map[BatchStatus]pb.BatchStatus{
+	BatchFailed:        pb.BatchStatus_FAILED,
+	BatchCreated:       pb.BatchStatus_CREATED,
+	BatchAlreadyExists: pb.BatchStatus_ALREADY_EXISTS,
+	BatchDeleted:       pb.BatchStatus_DELETED,
+	BatchNotFound:      pb.BatchStatus_NOT_FOUND,
+	BatchUpdated:       pb.BatchStatus_UPDATED,
+
+	BatchPermissionDenied: pb.BatchStatus_PERMISSION_DENIED,
+}
+
+// BatchResult is the result of a single item of a batch operation.
+type BatchResult struct {
+	ItemType string
+	ItemID   string
+	Status   BatchStatus
+
+	// Err is the reason of a BatchFailed Status.
+	Err error
+}
+
+// MarshalProto marshals r into result.
+func (r BatchResult) MarshalProto(result *pb.BatchFavoriteResult) error {
+	if r.ItemType == ItemTypeFile {
+		result.FileID = r.ItemID
+	}
+
+	result.ItemID = r.ItemID
+	result.Status = batchStatuses[r.Status]
+	if r.Err != nil {
+		result.Error = r.Err.Error()
+	}
+
+	return nil
+
+}
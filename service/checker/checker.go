@@ -0,0 +1,135 @@
+// Package checker implements service.FileExistenceChecker, checking whether files exist with the file
+// service, or with a static set of files for running the service locally and in tests.
+package checker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	fpb "github.com/meateam/fav-service/proto/file"
+	"github.com/meateam/fav-service/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxConcurrentChecks is the maximum number of concurrent requests to the file service
+// made by a single call to FileServiceChecker.Exist.
+const MaxConcurrentChecks = 16
+
+// StaticChecker must implement service.FileExistenceChecker.
+var _ service.FileExistenceChecker = (*StaticChecker)(nil)
+
+// StaticChecker checks whether files exist in a static set of existing files.
+type StaticChecker struct {
+	mu    sync.RWMutex
+	files map[string]bool
+}
+
+// NewStaticChecker returns a new StaticChecker whose existing files are the files of fileIDs.
+func NewStaticChecker(fileIDs ...string) *StaticChecker {
+	checker := &StaticChecker{files: make(map[string]bool, len(fileIDs))}
+	checker.Add(fileIDs...)
+
+	return checker
+
+}
+
+// Add adds the files of fileIDs to the existing files of c.
+func (c *StaticChecker) Add(fileIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, fileID := range fileIDs {
+		c.files[fileID] = true
+	}
+
+}
+
+// Remove removes the files of fileIDs from the existing files of c.
+func (c *StaticChecker) Remove(fileIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, fileID := range fileIDs {
+		delete(c.files, fileID)
+	}
+
+}
+
+// Exist returns whether each of the files of fileIDs is one of the existing files of c.
+func (c *StaticChecker) Exist(ctx context.Context, fileIDs []string) ([]bool, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	exist := make([]bool, len(fileIDs))
+	for i, fileID := range fileIDs {
+		exist[i] = c.files[fileID]
+	}
+
+	return exist, nil
+
+}
+
+// FileServiceChecker must implement service.FileExistenceChecker.
+var _ service.FileExistenceChecker = FileServiceChecker{}
+
+// FileServiceChecker checks whether files exist with the file service, a file exists unless
+// the file service returns a NotFound error for it.
+type FileServiceChecker struct {
+	client fpb.FileServiceClient
+}
+
+// NewFileServiceChecker returns a new FileServiceChecker using the file service of conn.
+func NewFileServiceChecker(conn *grpc.ClientConn) FileServiceChecker {
+	return FileServiceChecker{client: fpb.NewFileServiceClient(conn)}
+
+}
+
+// Exist returns whether each of the files of fileIDs exists, checking up to MaxConcurrentChecks
+// files at a time. Returns the first error of checking a file other than a NotFound error.
+func (c FileServiceChecker) Exist(ctx context.Context, fileIDs []string) ([]bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	exist := make([]bool, len(fileIDs))
+	checks := make(chan struct{}, MaxConcurrentChecks)
+
+	// firstErr is the error of the first failed check, which cancels the rest.
+	var firstErr error
+	var once sync.Once
+	var wg sync.WaitGroup
+	for i, fileID := range fileIDs {
+		wg.Add(1)
+		checks <- struct{}{}
+		go func(i int, fileID string) {
+			defer wg.Done()
+			defer func() { <-checks }()
+
+			_, err := c.client.GetFileByID(ctx, &fpb.GetByFileByIDRequest{Id: fileID})
+			if status.Code(err) == codes.NotFound {
+				return
+			}
+
+			if err != nil {
+				once.Do(func() {
+					firstErr = fmt.Errorf("failed getting file %s: %w", fileID, err)
+					cancel()
+				})
+				return
+			}
+
+			exist[i] = true
+		}(i, fileID)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return exist, nil
+
+}
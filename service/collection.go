@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/meateam/fav-service/proto"
+)
+
+// MaxCollectionNameLength is the maximum length of the name of a collection.
+const MaxCollectionNameLength = 256
+
+var (
+	// ErrCollectionNotFound is returned by a CollectionStore when the collection doesn't exist.
+	ErrCollectionNotFound = errors.New("collection not found")
+
+	// ErrCollectionAlreadyExists is returned by a CollectionStore when the user already has
+	// a collection with the same name.
+	ErrCollectionAlreadyExists = errors.New("collection already exists")
+)
+
+// Collection is a named collection of favorites of a user, such as "Q3 reports".
+// A favorite may be in any number of the collections of its user.
+type Collection struct {
+	ID        string
+	UserID    string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MarshalProto marshals c into collection.
+func (c Collection) MarshalProto(collection *pb.CollectionObject) error {
+	collection.Id = c.ID
+	collection.UserID = c.UserID
+	collection.Name = c.Name
+	collection.CreatedAt = UnixMillis(c.CreatedAt)
+	collection.UpdatedAt = UnixMillis(c.UpdatedAt)
+
+	return nil
+
+}
+
+// CollectionStore is an interface for storing the collections of users.
+// A collection is always accessed by its ID along with the userID it belongs to,
+// so a user can't access the collections of other users.
+type CollectionStore interface {
+	// Create stores collection and returns the stored collection with its ID and times set.
+	// Returns ErrCollectionAlreadyExists if the user already has a collection with the same name.
+	Create(ctx context.Context, collection Collection) (Collection, error)
+
+	// Get returns the collection of id and userID, or ErrCollectionNotFound if there is none.
+	Get(ctx context.Context, userID string, id string) (Collection, error)
+
+	// GetAll returns the collections of userID ordered by name, or an empty slice if there are none.
+	GetAll(ctx context.Context, userID string) ([]Collection, error)
+
+	// Rename sets the name of the collection of id and userID and returns the updated collection.
+	// Returns ErrCollectionNotFound if there is no such collection, or ErrCollectionAlreadyExists
+	// if the user already has another collection named name.
+	Rename(ctx context.Context, userID string, id string, name string) (Collection, error)
+
+	// Delete deletes the collection of id and userID and returns it,
+	// or ErrCollectionNotFound if there is none.
+	Delete(ctx context.Context, userID string, id string) (Collection, error)
+
+	// DeleteAll deletes all of the collections of userID and returns the number of deleted collections.
+	DeleteAll(ctx context.Context, userID string) (int64, error)
+}
@@ -0,0 +1,157 @@
+// Package consumer implements service.FileEventConsumer for running the service locally and in tests,
+// consuming the events of the lifecycle of files from a channel in the same process or from a file.
+package consumer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+)
+
+// ChannelConsumer must implement service.FileEventConsumer.
+var _ service.FileEventConsumer = (*ChannelConsumer)(nil)
+
+// ChannelConsumer consumes events from a channel in the same process.
+type ChannelConsumer struct {
+	Events chan service.FileEvent
+
+	mu sync.Mutex
+
+	// pending is the event that was received from the channel but wasn't consumed yet.
+	pending *service.FileEvent
+}
+
+// NewChannelConsumer returns a new ChannelConsumer whose channel buffers up to size events.
+func NewChannelConsumer(size int) *ChannelConsumer {
+	return &ChannelConsumer{Events: make(chan service.FileEvent, size)}
+
+}
+
+// Consume calls handle for each event received from the channel of c until ctx is done,
+// handle returns an error or the channel is closed.
+func (c *ChannelConsumer) Consume(ctx context.Context, handle func(ctx context.Context, event service.FileEvent) error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for {
+		if c.pending == nil {
+			select {
+			case event, ok := <-c.Events:
+				if !ok {
+					return nil
+				}
+
+				c.pending = &event
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if err := handle(ctx, *c.pending); err != nil {
+			return err
+		}
+
+		c.pending = nil
+	}
+
+}
+
+// FileConsumer must implement service.FileEventConsumer.
+var _ service.FileEventConsumer = (*FileConsumer)(nil)
+
+// FileConsumer consumes events from a file, each as a line of JSON, following the lines appended to it.
+// The offset of the consumed lines is kept in memory, so the file is consumed from its start again
+// by a new FileConsumer.
+type FileConsumer struct {
+	path         string
+	pollInterval time.Duration
+
+	mu sync.Mutex
+
+	// offset is the offset in the file after the last consumed line.
+	offset int64
+}
+
+// NewFileConsumer returns a new FileConsumer of the file of path, which is checked for
+// new lines once in pollInterval. The file doesn't have to exist until it's written to.
+func NewFileConsumer(path string, pollInterval time.Duration) *FileConsumer {
+	return &FileConsumer{path: path, pollInterval: pollInterval}
+
+}
+
+// Consume calls handle for each event in the file of c until ctx is done or handle returns an error.
+// A line that isn't a valid event is skipped, and the error of parsing it is returned.
+func (c *FileConsumer) Consume(ctx context.Context, handle func(ctx context.Context, event service.FileEvent) error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for {
+		if err := c.consumeLines(ctx, handle); err != nil {
+			return err
+		}
+
+		select {
+		case <-time.After(c.pollInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+}
+
+// consumeLines calls handle for each complete line in the file of c after c.offset, a line that
+// isn't complete yet is consumed once it's completed. c.mu must be held.
+func (c *FileConsumer) consumeLines(ctx context.Context, handle func(ctx context.Context, event service.FileEvent) error) error {
+	file, err := os.Open(c.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(c.offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(file)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(bytes.TrimSpace(line)) > 0 {
+			var event service.FileEvent
+			if err := json.Unmarshal(line, &event); err != nil {
+				c.offset += int64(len(line))
+				return fmt.Errorf("failed parsing file event %q: %w", bytes.TrimSpace(line), err)
+			}
+
+			if err := handle(ctx, event); err != nil {
+				return err
+			}
+		}
+
+		c.offset += int64(len(line))
+	}
+
+}
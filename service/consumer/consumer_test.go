@@ -0,0 +1,186 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+)
+
+// appendLines appends lines to the file of path, creating it if it doesn't exist.
+func appendLines(t *testing.T, path string, lines ...string) {
+	t.Helper()
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(strings.Join(lines, "")); err != nil {
+		t.Fatalf("WriteString() error = %v", err)
+	}
+
+}
+
+// eventLine returns the line of the event of eventType of fileID.
+func eventLine(eventType string, fileID string) string {
+	return `{"type":"` + eventType + `","fileID":"` + fileID + `"}` + "\n"
+
+}
+
+// consumeFileIDs consumes count events with c and returns their file IDs, failing t if Consume
+// returns another error than being canceled after count events. The events are consumed,
+// since handle returns nil for them and stops consuming by canceling its context.
+func consumeFileIDs(t *testing.T, c service.FileEventConsumer, count int) []string {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var fileIDs []string
+	err := c.Consume(ctx, func(ctx context.Context, event service.FileEvent) error {
+		fileIDs = append(fileIDs, event.FileID)
+		if len(fileIDs) == count {
+			cancel()
+		}
+
+		return nil
+	})
+	if err != context.Canceled {
+		t.Fatalf("Consume() error = %v, want %d events", err, count)
+	}
+
+	return fileIDs
+
+}
+
+// assertFileIDs fails t unless got and want hold the same file IDs in the same order.
+func assertFileIDs(t *testing.T, got []string, want ...string) {
+	t.Helper()
+
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("consumed fileIDs = %v, want %v", got, want)
+	}
+
+}
+
+func TestFileConsumerOffset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file-events.jsonl")
+	c := NewFileConsumer(path, time.Millisecond)
+
+	appendLines(t, path, eventLine(service.FileEventDeleted, "file1"), eventLine(service.FileEventTrashed, "file2"))
+	assertFileIDs(t, consumeFileIDs(t, c, 2), "file1", "file2")
+
+	// The consumed lines aren't consumed again by the same consumer.
+	appendLines(t, path, "\n", eventLine(service.FileEventRestored, "file3"))
+	assertFileIDs(t, consumeFileIDs(t, c, 1), "file3")
+
+	// A new consumer consumes the file from its start.
+	assertFileIDs(t, consumeFileIDs(t, NewFileConsumer(path, time.Millisecond), 3), "file1", "file2", "file3")
+
+}
+
+func TestFileConsumerPartialLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file-events.jsonl")
+	c := NewFileConsumer(path, time.Millisecond)
+
+	line := eventLine(service.FileEventDeleted, "file2")
+	appendLines(t, path, eventLine(service.FileEventDeleted, "file1"), line[:10])
+	assertFileIDs(t, consumeFileIDs(t, c, 1), "file1")
+
+	appendLines(t, path, line[10:])
+	assertFileIDs(t, consumeFileIDs(t, c, 1), "file2")
+
+}
+
+func TestFileConsumerRetry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file-events.jsonl")
+	c := NewFileConsumer(path, time.Millisecond)
+	appendLines(t, path, eventLine(service.FileEventDeleted, "file1"), eventLine(service.FileEventDeleted, "file2"))
+
+	handleErr := errors.New("store unavailable")
+	var handled []string
+	err := c.Consume(context.Background(), func(ctx context.Context, event service.FileEvent) error {
+		handled = append(handled, event.FileID)
+		if event.FileID == "file2" {
+			return handleErr
+		}
+
+		return nil
+	})
+	if err != handleErr {
+		t.Fatalf("Consume() error = %v, want %v", err, handleErr)
+	}
+
+	assertFileIDs(t, handled, "file1", "file2")
+
+	// The event that failed is the first event of the next call.
+	assertFileIDs(t, consumeFileIDs(t, c, 1), "file2")
+
+}
+
+func TestFileConsumerInvalidLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file-events.jsonl")
+	c := NewFileConsumer(path, time.Millisecond)
+	appendLines(t, path, "not json\n", eventLine(service.FileEventDeleted, "file1"))
+
+	err := c.Consume(context.Background(), func(ctx context.Context, event service.FileEvent) error {
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "failed parsing file event") {
+		t.Fatalf("Consume() error = %v, want a parsing error", err)
+	}
+
+	// The invalid line is skipped.
+	assertFileIDs(t, consumeFileIDs(t, c, 1), "file1")
+
+}
+
+func TestFileConsumerMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file-events.jsonl")
+	c := NewFileConsumer(path, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := c.Consume(ctx, func(ctx context.Context, event service.FileEvent) error {
+		t.Errorf("Consume() handled %+v of a missing file", event)
+		return nil
+	})
+	if err != context.DeadlineExceeded {
+		t.Errorf("Consume() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The file is consumed once it's written to.
+	appendLines(t, path, eventLine(service.FileEventDeleted, "file1"))
+	assertFileIDs(t, consumeFileIDs(t, c, 1), "file1")
+
+}
+
+func TestChannelConsumerRetry(t *testing.T) {
+	c := NewChannelConsumer(2)
+	c.Events <- service.FileEvent{Type: service.FileEventDeleted, FileID: "file1"}
+	c.Events <- service.FileEvent{Type: service.FileEventDeleted, FileID: "file2"}
+
+	handleErr := errors.New("store unavailable")
+	err := c.Consume(context.Background(), func(ctx context.Context, event service.FileEvent) error {
+		return handleErr
+	})
+	if err != handleErr {
+		t.Fatalf("Consume() error = %v, want %v", err, handleErr)
+	}
+
+	assertFileIDs(t, consumeFileIDs(t, c, 2), "file1", "file2")
+
+	close(c.Events)
+	if err := c.Consume(context.Background(), func(ctx context.Context, event service.FileEvent) error { return nil }); err != nil {
+		t.Errorf("Consume() of a closed channel error = %v, want nil", err)
+	}
+
+}
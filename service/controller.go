@@ -2,15 +2,54 @@ package service
 
 import (
 	"context"
-
+	"time"
 	// pb "github.com/meateam/fav-service/proto"
 )
 
+// FavoriteQuery narrows down the favorites of a user returned by GetAllFavorites,
+// empty fields match any favorite.
+type FavoriteQuery struct {
+	// CollectionID matches the favorites in the collection of CollectionID.
+	CollectionID string
+
+	// Tag matches the favorites tagged with Tag.
+	Tag string
+
+	// ItemType matches the favorites of items of ItemType.
+	ItemType string
+}
+
 // Controller is an interface for the business logic of the fav.Service which uses a Store.
 type Controller interface {
-	CreateFavorite(ctx context.Context, fileID string, userID string) (Favorite, error)
-	DeleteFavorite(ctx context.Context, fileID string, userID string) (Favorite, error)
-	GetAllFavorites(ctx context.Context, userID string) ([]string, error)
+	CreateFavorite(ctx context.Context, itemType string, itemID string, userID string, expiresAt time.Time, idempotent bool) (Favorite, error)
+	DeleteFavorite(ctx context.Context, itemType string, itemID string, userID string) (Favorite, error)
+	RestoreFavorite(ctx context.Context, itemType string, itemID string, userID string) (Favorite, error)
+	GetRecentlyDeletedFavorites(ctx context.Context, userID string, opts ListOptions) ([]Favorite, string, error)
+	ExpireFavorites(ctx context.Context) (int64, error)
+	PurgeDeletedFavorites(ctx context.Context) (int64, error)
+	GetAllFavorites(ctx context.Context, userID string, query FavoriteQuery, opts ListOptions) ([]Favorite, string, error)
+	ListFavorites(ctx context.Context, userID string, sort SortOrder, fn func(Favorite) error) error
+	IsFavorite(ctx context.Context, userID string, fileIDs []string) ([]bool, error)
+	CreateFavorites(ctx context.Context, userID string, itemType string, itemIDs []string) ([]BatchResult, error)
+	DeleteFavorites(ctx context.Context, userID string, itemType string, itemIDs []string) ([]BatchResult, error)
+	GetFileFavoriters(ctx context.Context, fileID string, opts ListOptions) ([]Favorite, string, error)
+	CountFileFavorites(ctx context.Context, fileID string) (int64, error)
+	DeleteFavoritesByFiles(ctx context.Context, fileIDs []string) (int64, error)
+	HandleFileEvent(ctx context.Context, event FileEvent) (int64, error)
+	ReconcileOrphans(ctx context.Context, checker FileExistenceChecker, opts ReconcileOptions) (ReconcileReport, error)
+	DeleteAllUserFavorites(ctx context.Context, userID string, reason string, requestedBy string) (int64, error)
+	TransferFavorites(ctx context.Context, sourceUserID string, targetUserID string, keepSource bool) (int64, int64, error)
+	CreateCollection(ctx context.Context, userID string, name string) (Collection, error)
+	GetCollections(ctx context.Context, userID string) ([]Collection, error)
+	RenameCollection(ctx context.Context, userID string, collectionID string, name string) (Collection, error)
+	DeleteCollection(ctx context.Context, userID string, collectionID string) (Collection, error)
+	AddToCollections(ctx context.Context, userID string, itemType string, itemIDs []string, collectionIDs []string) ([]BatchResult, error)
+	RemoveFromCollections(ctx context.Context, userID string, itemType string, itemIDs []string, collectionIDs []string) ([]BatchResult, error)
+	UpdateFavorite(ctx context.Context, itemType string, itemID string, userID string, update FavoriteUpdate) (Favorite, error)
+	ReorderFavorite(ctx context.Context, userID string, itemType string, itemID string, previousItemID string, nextItemID string) (Favorite, error)
+	GetFavoriteQuota(ctx context.Context, userID string) (QuotaUsage, error)
+	SetFavoriteQuota(ctx context.Context, userID string, limit int64) (QuotaUsage, error)
+	RelayEvents(ctx context.Context, publisher EventPublisher) (int, error)
+	WatchFavorites(ctx context.Context, userID string, resumeToken string, fn func(Change) error) error
 	HealthCheck(ctx context.Context) (bool, error)
-	
 }
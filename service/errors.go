@@ -0,0 +1,464 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the google.rpc.ErrorInfo detail of the service errors.
+const ErrorDomain = "fav-service"
+
+// The reasons of the google.rpc.ErrorInfo detail of the service errors,
+// clients may branch on them.
+const (
+	ReasonInvalidArgument         = "INVALID_ARGUMENT"
+	ReasonInvalidPageToken        = "INVALID_PAGE_TOKEN"
+	ReasonInvalidResumeToken      = "INVALID_RESUME_TOKEN"
+	ReasonFavoriteNotFound        = "FAVORITE_NOT_FOUND"
+	ReasonFavoriteAlreadyExists   = "FAVORITE_ALREADY_EXISTS"
+	ReasonDeletedFavoriteNotFound = "DELETED_FAVORITE_NOT_FOUND"
+	ReasonCollectionNotFound      = "COLLECTION_NOT_FOUND"
+	ReasonCollectionAlreadyExists = "COLLECTION_ALREADY_EXISTS"
+	ReasonConcurrentModification  = "CONCURRENT_MODIFICATION"
+	ReasonQuotaExceeded           = "QUOTA_EXCEEDED"
+	ReasonItemAccessDenied        = "ITEM_ACCESS_DENIED"
+	ReasonAuthorizerUnavailable   = "AUTHORIZER_UNAVAILABLE"
+	ReasonStoreUnavailable        = "STORE_UNAVAILABLE"
+	ReasonDeadlineExceeded        = "DEADLINE_EXCEEDED"
+	ReasonCanceled                = "CANCELED"
+	ReasonInternal                = "INTERNAL"
+)
+
+// ErrorKind is the kind of an Error, which determines its grpc status code.
+type ErrorKind int
+
+const (
+	// KindInternal is an unexpected error, returned as codes.Internal.
+	KindInternal ErrorKind = iota
+
+	// KindValidation is an invalid request error, returned as codes.InvalidArgument.
+	KindValidation
+
+	// KindNotFound is a missing favorite or collection error, returned as codes.NotFound.
+	KindNotFound
+
+	// KindConflict is an already existing favorite or collection error, returned as codes.AlreadyExists.
+	KindConflict
+
+	// KindAborted is a concurrent modification error that may be retried, returned as codes.Aborted.
+	KindAborted
+
+	// KindUnavailable is an unreachable store error that may be retried, returned as codes.Unavailable.
+	KindUnavailable
+
+	// KindDeadline is a timed out request error, returned as codes.DeadlineExceeded.
+	KindDeadline
+
+	// KindCanceled is a canceled request error, returned as codes.Canceled.
+	KindCanceled
+
+	// KindResourceExhausted is an exceeded quota error, returned as codes.ResourceExhausted.
+	KindResourceExhausted
+
+	// KindPermissionDenied is an error of accessing an item the user may not access,
+	// returned as codes.PermissionDenied.
+	KindPermissionDenied
+)
+
+// kindCodes maps an ErrorKind to its grpc status code.
+var kindCodes = map[ErrorKind]codes.Code{
+	KindInternal:    codes.Internal,
+	KindValidation:  codes.InvalidArgument,
+	KindNotFound:    codes.NotFound,
+	KindConflict:    codes.AlreadyExists,
+	KindAborted:     codes.Aborted,
+	KindUnavailable: codes.Unavailable,
+	KindDeadline:    codes.DeadlineExceeded,
+	KindCanceled:    codes.Canceled,
+
+	KindResourceExhausted: codes.ResourceExhausted,
+	KindPermissionDenied:  codes.PermissionDenied,
+}
+
+// FieldViolation describes a single invalid field of a request.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// QuotaViolation describes a single exceeded quota.
+type QuotaViolation struct {
+	Subject     string
+	Description string
+}
+
+// Error is a typed service error. It's returned to clients as a grpc status with the code
+// of its Kind, a google.rpc.ErrorInfo detail holding its Reason and Metadata,
+// a google.rpc.BadRequest detail holding its FieldViolations if there are any, and
+// a google.rpc.QuotaFailure detail holding its QuotaViolations if there are any.
+type Error struct {
+	Kind            ErrorKind
+	Reason          string
+	Message         string
+	Metadata        map[string]string
+	FieldViolations []FieldViolation
+	QuotaViolations []QuotaViolation
+
+	// Err is the underlying error, if any.
+	Err error
+}
+
+// Error returns the message of e followed by its underlying error.
+func (e *Error) Error() string {
+	if e.Err == nil || e.Err.Error() == e.Message {
+		return e.Message
+	}
+
+	return e.Message + ": " + e.Err.Error()
+
+}
+
+// Unwrap returns the underlying error of e.
+func (e *Error) Unwrap() error {
+	return e.Err
+
+}
+
+// GRPCStatus returns e as a grpc status with its details. The status message of an internal error is
+// only its Message, since its underlying error may expose the internals of the service.
+func (e *Error) GRPCStatus() *status.Status {
+	message := e.Error()
+	if e.Kind == KindInternal {
+		message = e.Message
+	}
+
+	st := status.New(kindCodes[e.Kind], message)
+
+	details := []proto.Message{
+		&errdetails.ErrorInfo{
+			Reason:   e.Reason,
+			Domain:   ErrorDomain,
+			Metadata: e.Metadata,
+		},
+	}
+
+	if len(e.FieldViolations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, violation := range e.FieldViolations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+
+		details = append(details, badRequest)
+	}
+
+	if len(e.QuotaViolations) > 0 {
+		quotaFailure := &errdetails.QuotaFailure{}
+		for _, violation := range e.QuotaViolations {
+			quotaFailure.Violations = append(quotaFailure.Violations, &errdetails.QuotaFailure_Violation{
+				Subject:     violation.Subject,
+				Description: violation.Description,
+			})
+		}
+
+		details = append(details, quotaFailure)
+	}
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+
+	return withDetails
+
+}
+
+// NewValidationError returns an error of an invalid field of a request.
+func NewValidationError(field string, description string) *Error {
+	return &Error{
+		Kind:            KindValidation,
+		Reason:          ReasonInvalidArgument,
+		Message:         description,
+		FieldViolations: []FieldViolation{{Field: field, Description: description}},
+	}
+
+}
+
+// NewFavoriteNotFoundError returns an error of a missing favorite of fileID and userID.
+func NewFavoriteNotFoundError(fileID string, userID string) *Error {
+	return &Error{
+		Kind:     KindNotFound,
+		Reason:   ReasonFavoriteNotFound,
+		Message:  "favorite not found",
+		Metadata: map[string]string{"fileID": fileID, "userID": userID},
+		Err:      ErrNotFound,
+	}
+
+}
+
+// NewFavoriteAlreadyExistsError returns an error of an already existing favorite of fileID and userID.
+func NewFavoriteAlreadyExistsError(fileID string, userID string) *Error {
+	return &Error{
+		Kind:     KindConflict,
+		Reason:   ReasonFavoriteAlreadyExists,
+		Message:  "favorite already exists",
+		Metadata: map[string]string{"fileID": fileID, "userID": userID},
+		Err:      ErrAlreadyExists,
+	}
+
+}
+
+// NewItemNotFoundError returns an error of a missing favorite of the item of itemType and itemID of userID.
+// The error of a file is the same as NewFavoriteNotFoundError.
+func NewItemNotFoundError(itemType string, itemID string, userID string) *Error {
+	err := NewFavoriteNotFoundError(itemID, userID)
+	if itemType != ItemTypeFile {
+		err.Metadata = map[string]string{"itemType": itemType, "itemID": itemID, "userID": userID}
+	}
+
+	return err
+
+}
+
+// NewItemAlreadyExistsError returns an error of an already existing favorite of the item of itemType and itemID
+// of userID. The error of a file is the same as NewFavoriteAlreadyExistsError.
+func NewItemAlreadyExistsError(itemType string, itemID string, userID string) *Error {
+	err := NewFavoriteAlreadyExistsError(itemID, userID)
+	if itemType != ItemTypeFile {
+		err.Metadata = map[string]string{"itemType": itemType, "itemID": itemID, "userID": userID}
+	}
+
+	return err
+
+}
+
+// NewItemAccessDeniedError returns an error of favoriting the item of itemType and itemID by userID,
+// who may not access it.
+func NewItemAccessDeniedError(itemType string, itemID string, userID string) *Error {
+	metadata := map[string]string{"fileID": itemID, "userID": userID}
+	if itemType != ItemTypeFile {
+		metadata = map[string]string{"itemType": itemType, "itemID": itemID, "userID": userID}
+	}
+
+	return &Error{
+		Kind:     KindPermissionDenied,
+		Reason:   ReasonItemAccessDenied,
+		Message:  "user may not access the item",
+		Metadata: metadata,
+	}
+
+}
+
+// NewDeletedItemNotFoundError returns an error of a missing deleted favorite of the item of itemType and itemID
+// of userID, which wasn't deleted or was deleted more than restoreWindow ago.
+func NewDeletedItemNotFoundError(itemType string, itemID string, userID string, restoreWindow time.Duration) *Error {
+	return &Error{
+		Kind:     KindNotFound,
+		Reason:   ReasonDeletedFavoriteNotFound,
+		Message:  fmt.Sprintf("deleted favorite not found, favorites may be restored up to %s after being deleted", restoreWindow),
+		Metadata: map[string]string{"itemType": itemType, "itemID": itemID, "userID": userID},
+		Err:      ErrNotFound,
+	}
+
+}
+
+// NewCollectionNotFoundError returns an error of a missing collection of collectionID and userID.
+func NewCollectionNotFoundError(collectionID string, userID string) *Error {
+	return &Error{
+		Kind:     KindNotFound,
+		Reason:   ReasonCollectionNotFound,
+		Message:  "collection not found",
+		Metadata: map[string]string{"collectionID": collectionID, "userID": userID},
+		Err:      ErrCollectionNotFound,
+	}
+
+}
+
+// NewCollectionAlreadyExistsError returns an error of an already existing collection named name of userID.
+func NewCollectionAlreadyExistsError(name string, userID string) *Error {
+	return &Error{
+		Kind:     KindConflict,
+		Reason:   ReasonCollectionAlreadyExists,
+		Message:  "collection already exists",
+		Metadata: map[string]string{"name": name, "userID": userID},
+		Err:      ErrCollectionAlreadyExists,
+	}
+
+}
+
+// NewAbortedError returns an error of a request that was aborted by a concurrent modification.
+func NewAbortedError(message string, metadata map[string]string) *Error {
+	return &Error{
+		Kind:     KindAborted,
+		Reason:   ReasonConcurrentModification,
+		Message:  message,
+		Metadata: metadata,
+	}
+
+}
+
+// NewQuotaExceededError returns an error of creating favorites that would make userID exceed limit,
+// while userID already has usage favorites.
+func NewQuotaExceededError(userID string, usage int64, limit int64) *Error {
+	return &Error{
+		Kind:    KindResourceExhausted,
+		Reason:  ReasonQuotaExceeded,
+		Message: fmt.Sprintf("favorite quota exceeded, user has %d of %d favorites", usage, limit),
+		Metadata: map[string]string{
+			"userID": userID,
+			"usage":  strconv.FormatInt(usage, 10),
+			"limit":  strconv.FormatInt(limit, 10),
+		},
+		QuotaViolations: []QuotaViolation{{
+			Subject:     "user:" + userID,
+			Description: fmt.Sprintf("user has %d of %d favorites", usage, limit),
+		}},
+	}
+
+}
+
+// NewUnavailableError returns an error of a store that couldn't be reached.
+func NewUnavailableError(err error) *Error {
+	return &Error{
+		Kind:    KindUnavailable,
+		Reason:  ReasonStoreUnavailable,
+		Message: "store unavailable",
+		Err:     err,
+	}
+
+}
+
+// NewAuthorizerUnavailableError returns an error of an authorizer that couldn't be reached.
+func NewAuthorizerUnavailableError(err error) *Error {
+	return &Error{
+		Kind:    KindUnavailable,
+		Reason:  ReasonAuthorizerUnavailable,
+		Message: "authorizer unavailable",
+		Err:     err,
+	}
+
+}
+
+// NewDeadlineError returns an error of a request that timed out.
+func NewDeadlineError(err error) *Error {
+	return &Error{
+		Kind:    KindDeadline,
+		Reason:  ReasonDeadlineExceeded,
+		Message: "deadline exceeded",
+		Err:     err,
+	}
+
+}
+
+// ToError converts err into an *Error. Typed errors are returned as is, the Store
+// and context errors are converted to their kind, and any other error is KindInternal.
+func ToError(err error) *Error {
+	var serviceErr *Error
+	if errors.As(err, &serviceErr) {
+		return serviceErr
+	}
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return &Error{Kind: KindNotFound, Reason: ReasonFavoriteNotFound, Message: err.Error(), Err: err}
+	case errors.Is(err, ErrAlreadyExists):
+		return &Error{Kind: KindConflict, Reason: ReasonFavoriteAlreadyExists, Message: err.Error(), Err: err}
+	case errors.Is(err, ErrCollectionNotFound):
+		return &Error{Kind: KindNotFound, Reason: ReasonCollectionNotFound, Message: err.Error(), Err: err}
+	case errors.Is(err, ErrCollectionAlreadyExists):
+		return &Error{Kind: KindConflict, Reason: ReasonCollectionAlreadyExists, Message: err.Error(), Err: err}
+	case errors.Is(err, ErrInvalidPageToken):
+		validationErr := NewValidationError("pageToken", err.Error())
+		validationErr.Reason = ReasonInvalidPageToken
+		return validationErr
+	case errors.Is(err, ErrInvalidResumeToken):
+		validationErr := NewValidationError("resumeToken", err.Error())
+		validationErr.Reason = ReasonInvalidResumeToken
+		return validationErr
+	case errors.Is(err, context.DeadlineExceeded):
+		return NewDeadlineError(err)
+	case errors.Is(err, context.Canceled):
+		return &Error{Kind: KindCanceled, Reason: ReasonCanceled, Message: "request canceled", Err: err}
+	default:
+		return &Error{Kind: KindInternal, Reason: ReasonInternal, Message: "internal error", Err: err}
+	}
+
+}
+
+// StatusError converts err into a grpc status error, errors that are already grpc
+// status errors are returned as is and any other error is converted using ToError.
+func StatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var serviceErr *Error
+	if errors.As(err, &serviceErr) {
+		return serviceErr.GRPCStatus().Err()
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	return ToError(err).GRPCStatus().Err()
+
+}
+
+// UnaryErrorInterceptor returns a grpc.UnaryServerInterceptor that converts the errors returned
+// by unary handlers into grpc status errors using StatusError, logging internal errors with logger.
+func UnaryErrorInterceptor(logger *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		logInternalError(logger, info.FullMethod, err)
+
+		return resp, StatusError(err)
+	}
+
+}
+
+// StreamErrorInterceptor returns a grpc.StreamServerInterceptor that converts the errors returned
+// by streaming handlers into grpc status errors using StatusError, logging internal errors with logger.
+func StreamErrorInterceptor(logger *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, stream)
+		logInternalError(logger, info.FullMethod, err)
+
+		return StatusError(err)
+	}
+
+}
+
+// logInternalError logs the underlying error of err with logger if StatusError converts err into
+// an internal error, since the underlying error isn't returned to the client.
+func logInternalError(logger *logrus.Logger, method string, err error) {
+	if err == nil {
+		return
+	}
+
+	var serviceErr *Error
+	if !errors.As(err, &serviceErr) {
+		if _, ok := status.FromError(err); ok {
+			return
+		}
+
+		serviceErr = ToError(err)
+	}
+
+	if serviceErr.Kind == KindInternal && serviceErr.Err != nil {
+		logger.Errorf("%s failed with internal error: %v", method, serviceErr.Err)
+	}
+
+}
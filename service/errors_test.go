@@ -0,0 +1,179 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		kind   ErrorKind
+		reason string
+	}{
+		{name: "NotFound", err: ErrNotFound, kind: KindNotFound, reason: ReasonFavoriteNotFound},
+		{name: "WrappedNotFound", err: fmt.Errorf("failed updating favorite: %w", ErrNotFound), kind: KindNotFound, reason: ReasonFavoriteNotFound},
+		{name: "AlreadyExists", err: ErrAlreadyExists, kind: KindConflict, reason: ReasonFavoriteAlreadyExists},
+		{name: "CollectionNotFound", err: ErrCollectionNotFound, kind: KindNotFound, reason: ReasonCollectionNotFound},
+		{name: "CollectionAlreadyExists", err: ErrCollectionAlreadyExists, kind: KindConflict, reason: ReasonCollectionAlreadyExists},
+		{name: "InvalidPageToken", err: ErrInvalidPageToken, kind: KindValidation, reason: ReasonInvalidPageToken},
+		{name: "InvalidResumeToken", err: ErrInvalidResumeToken, kind: KindValidation, reason: ReasonInvalidResumeToken},
+		{name: "DeadlineExceeded", err: context.DeadlineExceeded, kind: KindDeadline, reason: ReasonDeadlineExceeded},
+		{name: "Canceled", err: context.Canceled, kind: KindCanceled, reason: ReasonCanceled},
+		{name: "ServiceError", err: fmt.Errorf("failed creating favorite: %w", NewQuotaExceededError("user", 1, 1)), kind: KindResourceExhausted, reason: ReasonQuotaExceeded},
+		{name: "Internal", err: errors.New("connection reset"), kind: KindInternal, reason: ReasonInternal},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := ToError(tt.err)
+			if err.Kind != tt.kind || err.Reason != tt.reason {
+				t.Errorf("ToError() = kind %d reason %s, want kind %d reason %s", err.Kind, err.Reason, tt.kind, tt.reason)
+			}
+		})
+	}
+
+}
+
+func TestGRPCStatusCodes(t *testing.T) {
+	tests := []struct {
+		err  *Error
+		code codes.Code
+	}{
+		{err: &Error{Kind: KindInternal}, code: codes.Internal},
+		{err: NewValidationError("fileID", "fileID is required"), code: codes.InvalidArgument},
+		{err: NewFavoriteNotFoundError("file", "user"), code: codes.NotFound},
+		{err: NewFavoriteAlreadyExistsError("file", "user"), code: codes.AlreadyExists},
+		{err: NewAbortedError("try again", nil), code: codes.Aborted},
+		{err: NewUnavailableError(errors.New("no reachable servers")), code: codes.Unavailable},
+		{err: NewDeadlineError(context.DeadlineExceeded), code: codes.DeadlineExceeded},
+		{err: ToError(context.Canceled), code: codes.Canceled},
+		{err: NewQuotaExceededError("user", 1, 1), code: codes.ResourceExhausted},
+		{err: NewItemAccessDeniedError(ItemTypeFile, "file", "user"), code: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		if code := tt.err.GRPCStatus().Code(); code != tt.code {
+			t.Errorf("GRPCStatus() of %v code = %s, want %s", tt.err, code, tt.code)
+		}
+	}
+
+}
+
+func TestGRPCStatusDetails(t *testing.T) {
+	st := NewValidationError("fileID", "fileID is required").GRPCStatus()
+
+	var errorInfo *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			errorInfo = detail
+		case *errdetails.BadRequest:
+			badRequest = detail
+		}
+	}
+
+	if errorInfo == nil || errorInfo.Reason != ReasonInvalidArgument || errorInfo.Domain != ErrorDomain {
+		t.Errorf("GRPCStatus() ErrorInfo = %v, want reason %s of domain %s", errorInfo, ReasonInvalidArgument, ErrorDomain)
+	}
+
+	if badRequest == nil || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != "fileID" {
+		t.Errorf("GRPCStatus() BadRequest = %v, want a violation of fileID", badRequest)
+	}
+
+	st = NewQuotaExceededError("user", 3, 3).GRPCStatus()
+
+	var quotaFailure *errdetails.QuotaFailure
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			errorInfo = detail
+		case *errdetails.QuotaFailure:
+			quotaFailure = detail
+		}
+	}
+
+	if errorInfo.Reason != ReasonQuotaExceeded || errorInfo.Metadata["userID"] != "user" || errorInfo.Metadata["limit"] != "3" {
+		t.Errorf("GRPCStatus() ErrorInfo = %v, want reason %s with the user and limit", errorInfo, ReasonQuotaExceeded)
+	}
+
+	if quotaFailure == nil || len(quotaFailure.Violations) != 1 || quotaFailure.Violations[0].Subject != "user:user" {
+		t.Errorf("GRPCStatus() QuotaFailure = %v, want a violation of user:user", quotaFailure)
+	}
+
+}
+
+func TestGRPCStatusMessage(t *testing.T) {
+	internal := ToError(errors.New("dial tcp 10.0.0.1:27017: connection refused"))
+	if message := internal.GRPCStatus().Message(); message != "internal error" {
+		t.Errorf("GRPCStatus() of an internal error message = %q, want only the error message", message)
+	}
+
+	unavailable := NewUnavailableError(errors.New("no reachable servers"))
+	if message := unavailable.GRPCStatus().Message(); message != "store unavailable: no reachable servers" {
+		t.Errorf("GRPCStatus() of an unavailable error message = %q, want the underlying error", message)
+	}
+
+}
+
+func TestStatusError(t *testing.T) {
+	if err := StatusError(nil); err != nil {
+		t.Errorf("StatusError(nil) = %v, want nil", err)
+	}
+
+	statusErr := status.Error(codes.Unauthenticated, "unauthenticated")
+	if err := StatusError(statusErr); err != statusErr {
+		t.Errorf("StatusError() of a status error = %v, want it as is", err)
+	}
+
+	wrapped := fmt.Errorf("failed deleting favorite: %w", NewFavoriteNotFoundError("file", "user"))
+	if code := status.Code(StatusError(wrapped)); code != codes.NotFound {
+		t.Errorf("StatusError() of a wrapped service error code = %s, want %s", code, codes.NotFound)
+	}
+
+	if code := status.Code(StatusError(errors.New("unexpected"))); code != codes.Internal {
+		t.Errorf("StatusError() of an unexpected error code = %s, want %s", code, codes.Internal)
+	}
+
+}
+
+func TestLogInternalError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		logged bool
+	}{
+		{name: "Internal", err: errors.New("connection reset"), logged: true},
+		{name: "WrappedInternal", err: fmt.Errorf("failed creating favorite: %w", errors.New("connection reset")), logged: true},
+		{name: "NotFound", err: NewFavoriteNotFoundError("file", "user"), logged: false},
+		{name: "StatusError", err: status.Error(codes.Unauthenticated, "connection reset"), logged: false},
+		{name: "Nil", err: nil, logged: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+			logger := logrus.New()
+			logger.SetOutput(&output)
+
+			logInternalError(logger, "/fav.Favorite/CreateFavorite", tt.err)
+			if logged := strings.Contains(output.String(), "connection reset"); logged != tt.logged {
+				t.Errorf("logInternalError() logged = %v, want %v, output %q", logged, tt.logged, output.String())
+			}
+		})
+	}
+
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// The types of the events of favorite mutations.
+const (
+	// EventFavoriteCreated is the type of the event of creating, restoring or transferring a favorite,
+	// or of showing a hidden favorite again when its file is restored from the trash.
+	EventFavoriteCreated = "FavoriteCreated"
+
+	// EventFavoriteDeleted is the type of the event of deleting or transferring a favorite,
+	// or of hiding it when its file is trashed or found missing.
+	EventFavoriteDeleted = "FavoriteDeleted"
+
+	// EventFavoriteUpdated is the type of the event of updating the tags, note, pinned flag or rank of a favorite.
+	EventFavoriteUpdated = "FavoriteUpdated"
+
+	// EventFavoritePurged is the type of the event of permanently deleting a favorite
+	// after it was deleted for longer than the restore window.
+	EventFavoritePurged = "FavoritePurged"
+)
+
+// RelayBatchSize is the maximum number of events claimed from the outbox by a single relay.
+const RelayBatchSize = 100
+
+// RelayLease is the duration during which the events claimed by a relay can't be claimed by another relay.
+// Events that weren't published within their lease are claimed again, so events are published at least once.
+const RelayLease = time.Minute
+
+// Event is an event of a favorite mutation, published to other services such as search ranking
+// and notifications. Events may be published more than once, consumers should ignore events
+// with an ID they've already handled.
+type Event struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	UserID     string    `json:"userID"`
+	ItemType   string    `json:"itemType"`
+	ItemID     string    `json:"itemID"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// NewEvent returns an event of eventType of favorite that occurred at occurredAt, its ID is set by the Outbox.
+func NewEvent(eventType string, favorite Favorite, occurredAt time.Time) Event {
+	return Event{
+		Type:       eventType,
+		UserID:     favorite.GetUserID(),
+		ItemType:   favorite.GetItemType(),
+		ItemID:     favorite.GetItemID(),
+		OccurredAt: occurredAt.UTC().Truncate(time.Millisecond),
+	}
+
+}
+
+// EventPublisher is an interface for publishing events to other services.
+type EventPublisher interface {
+	// Publish publishes event, returns an error if it might not have been published.
+	Publish(ctx context.Context, event Event) error
+}
+
+// Outbox is an interface for storing the events of favorite mutations until they're published.
+type Outbox interface {
+	// Add stores events to be published, setting their IDs.
+	Add(ctx context.Context, events []Event) error
+
+	// Claim claims up to limit stored events that aren't claimed, oldest first, for lease.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]Event, error)
+
+	// Delete deletes the events of ids after they were published.
+	Delete(ctx context.Context, ids []string) error
+}
+
+// RelayEvents claims up to limit events from outbox, publishes them in order with publisher and deletes
+// the published events. Publishing stops at the first event that fails to be published, which is claimed
+// again once its lease ends. Returns the number of published events.
+func RelayEvents(ctx context.Context, outbox Outbox, publisher EventPublisher, limit int) (int, error) {
+	events, err := outbox.Claim(ctx, limit, RelayLease)
+	if err != nil {
+		return 0, fmt.Errorf("failed claiming events: %w", err)
+	}
+
+	published := make([]string, 0, len(events))
+	var publishErr error
+	for _, event := range events {
+		if publishErr = publisher.Publish(ctx, event); publishErr != nil {
+			publishErr = fmt.Errorf("failed publishing event %s: %w", event.ID, publishErr)
+			break
+		}
+
+		published = append(published, event.ID)
+	}
+
+	if len(published) > 0 {
+		if err := outbox.Delete(ctx, published); err != nil {
+			return len(published), fmt.Errorf("failed deleting published events: %w", err)
+		}
+	}
+
+	return len(published), publishErr
+
+}
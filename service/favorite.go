@@ -1,12 +1,76 @@
 package service
 
 import (
+	"fmt"
+	"time"
 
 	pb "github.com/meateam/fav-service/proto"
 )
 
-// Favorite is an interface of a favorite object (UserID, FileID)
+const (
+	// MaxTags is the maximum number of tags of a favorite.
+	MaxTags = 20
+
+	// MaxTagLength is the maximum length of a tag of a favorite.
+	MaxTagLength = 64
+
+	// MaxNoteLength is the maximum length of the note of a favorite.
+	MaxNoteLength = 1024
+)
+
+// The types of the items that may be favorited.
+const (
+	ItemTypeFile        = "file"
+	ItemTypeFolder      = "folder"
+	ItemTypeSharedDrive = "sharedDrive"
+	ItemTypeSavedSearch = "savedSearch"
+	ItemTypePerson      = "person"
+)
+
+// itemTypes are the valid item types.
+var itemTypes = map[string]bool{
+	ItemTypeFile:        true,
+	ItemTypeFolder:      true,
+	ItemTypeSharedDrive: true,
+	ItemTypeSavedSearch: true,
+	ItemTypePerson:      true,
+}
+
+// FavoriteUpdate is an update of the user-defined fields of a favorite.
+// Only the fields that are set are updated.
+type FavoriteUpdate struct {
+	// Tags replace the tags of the favorite if SetTags is true.
+	Tags    []string
+	SetTags bool
+
+	// Note replaces the note of the favorite if SetNote is true.
+	Note    string
+	SetNote bool
+
+	// Rank replaces the rank of the favorite if SetRank is true.
+	Rank    string
+	SetRank bool
+
+	// Pinned replaces the pinned flag of the favorite if SetPinned is true.
+	Pinned    bool
+	SetPinned bool
+
+	// DeletedAt replaces the deletion time of the favorite if SetDeletedAt is true,
+	// the zero time restores a deleted favorite.
+	DeletedAt    time.Time
+	SetDeletedAt bool
+}
+
+// Favorite is an interface of a favorite object (UserID, ItemType, ItemID).
+// A favorite is unique by its user, item type and item ID.
 type Favorite interface {
+	// GetItemType returns the type of the favorited item, ItemTypeFile if it has none.
+	GetItemType() string
+
+	// GetItemID returns the ID of the favorited item.
+	GetItemID() string
+
+	// GetFileID returns the ID of the favorited item if it's a file, or an empty string otherwise.
 	GetFileID() string
 
 	SetFileID(fileID string) error
@@ -15,5 +79,188 @@ type Favorite interface {
 
 	SetUserID(userID string) error
 
+	GetCreatedAt() time.Time
+
+	GetUpdatedAt() time.Time
+
+	// GetCollectionIDs returns the IDs of the collections that the favorite is in.
+	GetCollectionIDs() []string
+
+	// GetTags returns the user-defined tags of the favorite.
+	GetTags() []string
+
+	// GetNote returns the user-defined note of the favorite.
+	GetNote() string
+
+	// GetRank returns the rank of the favorite in the SortManual order of its user.
+	GetRank() string
+
+	// GetPinned returns true if the favorite is pinned to the top of the SortManual order of its user.
+	GetPinned() bool
+
+	// GetExpiresAt returns the time the favorite expires, or the zero time if it never expires.
+	GetExpiresAt() time.Time
+
+	// GetDeletedAt returns the time the favorite was deleted, or the zero time if it wasn't deleted.
+	// A deleted favorite may be restored until it's purged.
+	GetDeletedAt() time.Time
+
 	MarshalProto(favorite *pb.FavoriteObject) error
-}
\ No newline at end of file
+}
+
+// Expired returns true if favorite has expired at now.
+func Expired(favorite Favorite, now time.Time) bool {
+	expiresAt := favorite.GetExpiresAt()
+	return !expiresAt.IsZero() && !expiresAt.After(now)
+
+}
+
+// UnixMillis returns t as the number of milliseconds since the unix epoch,
+// which is how times are represented in pb.FavoriteObject. The zero time is returned as 0.
+func UnixMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano() / int64(time.Millisecond)
+
+}
+
+// favoriteValue is a favorite that is passed to a Store to be created, the Store copies its fields.
+type favoriteValue struct {
+	itemType  string
+	itemID    string
+	userID    string
+	createdAt time.Time
+	tags      []string
+	note      string
+	rank      string
+	pinned    bool
+	expiresAt time.Time
+}
+
+// GetItemType returns f.itemType, or ItemTypeFile if f has no item type.
+func (f favoriteValue) GetItemType() string {
+	if f.itemType == "" {
+		return ItemTypeFile
+	}
+
+	return f.itemType
+
+}
+
+// GetItemID returns f.itemID.
+func (f favoriteValue) GetItemID() string {
+	return f.itemID
+
+}
+
+// GetFileID returns f.itemID if f is a favorite of a file, or an empty string otherwise.
+func (f favoriteValue) GetFileID() string {
+	if f.GetItemType() != ItemTypeFile {
+		return ""
+	}
+
+	return f.itemID
+
+}
+
+// SetFileID sets f.itemID to fileID.
+func (f *favoriteValue) SetFileID(fileID string) error {
+	if fileID == "" {
+		return fmt.Errorf("FileID is required")
+	}
+
+	f.itemID = fileID
+	f.itemType = ItemTypeFile
+	return nil
+
+}
+
+// GetUserID returns f.userID.
+func (f favoriteValue) GetUserID() string {
+	return f.userID
+
+}
+
+// SetUserID sets f.userID to userID.
+func (f *favoriteValue) SetUserID(userID string) error {
+	if userID == "" {
+		return fmt.Errorf("UserID is required")
+	}
+
+	f.userID = userID
+	return nil
+
+}
+
+// GetCreatedAt returns f.createdAt.
+func (f favoriteValue) GetCreatedAt() time.Time {
+	return f.createdAt
+
+}
+
+// GetUpdatedAt returns the zero time, since f wasn't stored yet.
+func (f favoriteValue) GetUpdatedAt() time.Time {
+	return time.Time{}
+
+}
+
+// GetCollectionIDs returns nil, since a favorite is created without collections.
+func (f favoriteValue) GetCollectionIDs() []string {
+	return nil
+
+}
+
+// GetTags returns f.tags.
+func (f favoriteValue) GetTags() []string {
+	return f.tags
+
+}
+
+// GetNote returns f.note.
+func (f favoriteValue) GetNote() string {
+	return f.note
+
+}
+
+// GetRank returns f.rank.
+func (f favoriteValue) GetRank() string {
+	return f.rank
+
+}
+
+// GetPinned returns f.pinned.
+func (f favoriteValue) GetPinned() bool {
+	return f.pinned
+
+}
+
+// GetExpiresAt returns f.expiresAt.
+func (f favoriteValue) GetExpiresAt() time.Time {
+	return f.expiresAt
+
+}
+
+// GetDeletedAt returns the zero time, since favorites are created without being deleted.
+func (f favoriteValue) GetDeletedAt() time.Time {
+	return time.Time{}
+
+}
+
+// MarshalProto marshals f into a favorite.
+func (f favoriteValue) MarshalProto(favorite *pb.FavoriteObject) error {
+	favorite.FileID = f.GetFileID()
+	favorite.ItemType = f.GetItemType()
+	favorite.ItemID = f.GetItemID()
+	favorite.UserID = f.GetUserID()
+	favorite.CreatedAt = UnixMillis(f.GetCreatedAt())
+	favorite.Tags = f.GetTags()
+	favorite.Note = f.GetNote()
+	favorite.Rank = f.GetRank()
+	favorite.Pinned = f.GetPinned()
+	favorite.ExpiresAt = UnixMillis(f.GetExpiresAt())
+
+	return nil
+
+}
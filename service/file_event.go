@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// The types of the events of the lifecycle of files.
+const (
+	// FileEventDeleted is the type of the event of permanently deleting a file.
+	FileEventDeleted = "FileDeleted"
+
+	// FileEventTrashed is the type of the event of moving a file to the trash.
+	FileEventTrashed = "FileTrashed"
+
+	// FileEventRestored is the type of the event of restoring a file from the trash.
+	FileEventRestored = "FileRestored"
+)
+
+// FileEvent is an event of the lifecycle of a file, consumed from the file service.
+// Handling an event is idempotent, so events may be consumed more than once.
+type FileEvent struct {
+	Type       string    `json:"type"`
+	FileID     string    `json:"fileID"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// FileEventConsumer is an interface for consuming the events of the lifecycle of files.
+type FileEventConsumer interface {
+	// Consume calls handle for each event in order until ctx is done or handle returns an error,
+	// and returns that error. An event is consumed once handle returns nil for it, the event
+	// that handle failed for is the first event of the next call to Consume.
+	Consume(ctx context.Context, handle func(ctx context.Context, event FileEvent) error) error
+}
@@ -0,0 +1,27 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/meateam/fav-service/service"
+)
+
+// AuditStore must implement service.AuditStore.
+var _ service.AuditStore = (*AuditStore)(nil)
+
+// AuditStore holds the audit records in memory and implements AuditStore interface.
+type AuditStore struct {
+	mu      sync.RWMutex
+	records []service.AuditRecord
+}
+
+// Create stores record.
+func (s *AuditStore) Create(ctx context.Context, record service.AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records = append(s.records, record)
+	return nil
+
+}
@@ -0,0 +1,169 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+)
+
+// CollectionStore must implement service.CollectionStore.
+var _ service.CollectionStore = (*CollectionStore)(nil)
+
+// CollectionStore holds the collections in memory and implements CollectionStore interface.
+// Each collection gets an increasing id as its ID.
+type CollectionStore struct {
+	mu          sync.RWMutex
+	collections []*service.Collection
+	lastID      uint64
+}
+
+// newCollectionStore returns a new empty collection store.
+func newCollectionStore() *CollectionStore {
+	return &CollectionStore{}
+
+}
+
+// Create creates collection, returns service.ErrCollectionAlreadyExists if its user
+// already has a collection with the same name.
+func (s *CollectionStore) Create(ctx context.Context, collection service.Collection) (service.Collection, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.findName(collection.UserID, collection.Name) != -1 {
+		return service.Collection{}, service.ErrCollectionAlreadyExists
+	}
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	s.lastID++
+	created := service.Collection{
+		ID:        strconv.FormatUint(s.lastID, 10),
+		UserID:    collection.UserID,
+		Name:      collection.Name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	s.collections = append(s.collections, &created)
+
+	return created, nil
+
+}
+
+// Get returns the collection of id and userID, or service.ErrCollectionNotFound if there is none.
+func (s *CollectionStore) Get(ctx context.Context, userID string, id string) (service.Collection, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	i := s.find(userID, id)
+	if i == -1 {
+		return service.Collection{}, service.ErrCollectionNotFound
+	}
+
+	return *s.collections[i], nil
+
+}
+
+// GetAll returns the collections of userID ordered by name.
+func (s *CollectionStore) GetAll(ctx context.Context, userID string) ([]service.Collection, error) {
+	s.mu.RLock()
+	collections := []service.Collection{}
+	for _, collection := range s.collections {
+		if collection.UserID == userID {
+			collections = append(collections, *collection)
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(collections, func(i int, j int) bool {
+		return collections[i].Name < collections[j].Name
+	})
+
+	return collections, nil
+
+}
+
+// Rename sets the name of the collection of id and userID to name and returns it.
+func (s *CollectionStore) Rename(ctx context.Context, userID string, id string, name string) (service.Collection, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.find(userID, id)
+	if i == -1 {
+		return service.Collection{}, service.ErrCollectionNotFound
+	}
+
+	if j := s.findName(userID, name); j != -1 && j != i {
+		return service.Collection{}, service.ErrCollectionAlreadyExists
+	}
+
+	s.collections[i].Name = name
+	s.collections[i].UpdatedAt = time.Now().UTC().Truncate(time.Millisecond)
+
+	return *s.collections[i], nil
+
+}
+
+// Delete deletes the collection of id and userID and returns it.
+func (s *CollectionStore) Delete(ctx context.Context, userID string, id string) (service.Collection, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.find(userID, id)
+	if i == -1 {
+		return service.Collection{}, service.ErrCollectionNotFound
+	}
+
+	deleted := s.collections[i]
+	s.collections = append(s.collections[:i], s.collections[i+1:]...)
+
+	return *deleted, nil
+
+}
+
+// DeleteAll deletes all of the collections of userID and returns the number of deleted collections.
+func (s *CollectionStore) DeleteAll(ctx context.Context, userID string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.collections[:0]
+	for _, collection := range s.collections {
+		if collection.UserID != userID {
+			kept = append(kept, collection)
+		}
+	}
+
+	deletedCount := int64(len(s.collections) - len(kept))
+	s.collections = kept
+
+	return deletedCount, nil
+
+}
+
+// find returns the index of the collection of id and userID, or -1 if there is none.
+// s.mu must be held by the caller.
+func (s *CollectionStore) find(userID string, id string) int {
+	for i, collection := range s.collections {
+		if collection.UserID == userID && collection.ID == id {
+			return i
+		}
+	}
+
+	return -1
+
+}
+
+// findName returns the index of the collection of userID named name, or -1 if there is none.
+// s.mu must be held by the caller.
+func (s *CollectionStore) findName(userID string, name string) int {
+	for i, collection := range s.collections {
+		if collection.UserID == userID && collection.Name == name {
+			return i
+		}
+	}
+
+	return -1
+
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/meateam/fav-service/service"
+)
+
+// filterBuilder must implement service.FilterBuilder.
+var _ service.FilterBuilder = filterBuilder{}
+
+// filterBuilder builds the filters of Store.
+type filterBuilder struct{}
+
+// Filter returns the Filter that matches the favorites matching filter.
+func (filterBuilder) Filter(filter service.FavoriteFilter) interface{} {
+	return Filter{
+		UserID:        filter.UserID,
+		ItemType:      filter.ItemType,
+		FileIDs:       filter.ItemIDs,
+		CollectionID:  filter.CollectionID,
+		Tag:           filter.Tag,
+		ActiveAt:      filter.ActiveAt,
+		IncludeHidden: filter.IncludeHidden,
+		Hidden:        filter.Hidden,
+		Orphaned:      filter.Orphaned,
+		InactiveAt:    filter.InactiveAt,
+		ExpiredAt:     filter.ExpiredAt,
+		DeletedAfter:  filter.DeletedAfter,
+		DeletedBefore: filter.DeletedBefore,
+	}
+
+}
+
+// NewMemoryController returns a new controller with empty in-memory stores, whose deleted
+// favorites may be restored for restoreWindow and whose users may have up to defaultQuota
+// favorites unless their quota is overridden. If publishEvents is set, the events of favorite
+// mutations are kept in an in-memory outbox to be relayed. Only the changes made by the returned
+// controller are watched.
+func NewMemoryController(restoreWindow time.Duration, defaultQuota int64, publishEvents bool) (service.StoreController, error) {
+	config := service.StoreControllerConfig{
+		Store:           newStore(),
+		AuditStore:      &AuditStore{},
+		CollectionStore: newCollectionStore(),
+		QuotaStore:      newQuotaStore(),
+		Filters:         filterBuilder{},
+		RestoreWindow:   restoreWindow,
+		DefaultQuota:    defaultQuota,
+	}
+
+	if publishEvents {
+		config.Outbox = newOutbox()
+	}
+
+	return service.NewStoreController(config), nil
+
+}
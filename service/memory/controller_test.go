@@ -0,0 +1,981 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+	"github.com/meateam/fav-service/service/checker"
+)
+
+// newTestController returns a new memory controller that publishes events, whose deleted favorites
+// may be restored for restoreWindow and whose users may have up to defaultQuota favorites.
+func newTestController(t *testing.T, restoreWindow time.Duration, defaultQuota int64) service.StoreController {
+	t.Helper()
+
+	controller, err := NewMemoryController(restoreWindow, defaultQuota, true)
+	if err != nil {
+		t.Fatalf("NewMemoryController() error = %v", err)
+	}
+
+	return controller
+
+}
+
+// mustCreateFavorite creates a favorite of fileID of userID with c and fails t if it can't be created.
+func mustCreateFavorite(t *testing.T, c service.Controller, fileID string, userID string) service.Favorite {
+	t.Helper()
+
+	favorite, err := c.CreateFavorite(context.Background(), service.ItemTypeFile, fileID, userID, time.Time{}, false)
+	if err != nil {
+		t.Fatalf("CreateFavorite(%s, %s) error = %v", fileID, userID, err)
+	}
+
+	return favorite
+
+}
+
+// assertKind fails t unless err is a service error of kind.
+func assertKind(t *testing.T, err error, kind service.ErrorKind) {
+	t.Helper()
+
+	var serviceErr *service.Error
+	if !errors.As(err, &serviceErr) || serviceErr.Kind != kind {
+		t.Fatalf("error = %v, want a service error of kind %d", err, kind)
+	}
+
+}
+
+// assertActive fails t unless the active favorites of userID are the favorites of fileIDs.
+func assertActive(t *testing.T, c service.Controller, userID string, fileIDs ...string) {
+	t.Helper()
+
+	favorites, _, err := c.GetAllFavorites(context.Background(), userID, service.FavoriteQuery{}, service.ListOptions{Sort: service.SortByFileID})
+	if err != nil {
+		t.Fatalf("GetAllFavorites() error = %v", err)
+	}
+
+	if got := favoriteFileIDs(favorites); !equalStrings(got, fileIDs) {
+		t.Errorf("GetAllFavorites() of %s fileIDs = %v, want %v", userID, got, fileIDs)
+	}
+
+}
+
+// favoriteFileIDs returns the file IDs of favorites in their order.
+func favoriteFileIDs(favorites []service.Favorite) []string {
+	fileIDs := make([]string, 0, len(favorites))
+	for _, favorite := range favorites {
+		fileIDs = append(fileIDs, favorite.GetFileID())
+	}
+
+	return fileIDs
+
+}
+
+// equalStrings returns true if a and b hold the same strings in the same order.
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+
+}
+
+// racingQuotaStore is a QuotaStore that increments the version of a quota right before each of the first
+// races increments of its version, as if favorites of the same user were added concurrently.
+type racingQuotaStore struct {
+	*QuotaStore
+	races      int
+	increments int
+}
+
+// IncrementVersion increments the version of the quota of userID if it's still version,
+// after another increment if races haven't run out.
+func (s *racingQuotaStore) IncrementVersion(ctx context.Context, userID string, version int64) (bool, error) {
+	s.increments++
+	if s.increments <= s.races {
+		if _, err := s.QuotaStore.IncrementVersion(ctx, userID, version); err != nil {
+			return false, err
+		}
+	}
+
+	return s.QuotaStore.IncrementVersion(ctx, userID, version)
+
+}
+
+// newRacingController returns a new memory controller whose users may have a single favorite
+// and whose quota store is quotaStore.
+func newRacingController(quotaStore *racingQuotaStore) service.StoreController {
+	return service.NewStoreController(service.StoreControllerConfig{
+		Store:           newStore(),
+		AuditStore:      &AuditStore{},
+		CollectionStore: newCollectionStore(),
+		QuotaStore:      quotaStore,
+		Filters:         filterBuilder{},
+		DefaultQuota:    1,
+	})
+
+}
+
+func TestControllerQuota(t *testing.T) {
+	c := newTestController(t, time.Hour, 2)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "user")
+	mustCreateFavorite(t, c, "file2", "user")
+
+	_, err := c.CreateFavorite(ctx, service.ItemTypeFile, "file3", "user", time.Time{}, false)
+	assertKind(t, err, service.KindResourceExhausted)
+
+	_, err = c.CreateFavorites(ctx, "user", service.ItemTypeFile, []string{"file3", "file4"})
+	assertKind(t, err, service.KindResourceExhausted)
+	assertActive(t, c, "user", "file1", "file2")
+
+	// The quota of each user is separate.
+	mustCreateFavorite(t, c, "file3", "other")
+
+	quota, err := c.SetFavoriteQuota(ctx, "user", 3)
+	if err != nil {
+		t.Fatalf("SetFavoriteQuota() error = %v", err)
+	}
+
+	if quota.Usage != 2 || quota.Limit != 3 {
+		t.Errorf("SetFavoriteQuota() = %+v, want a usage of 2 and a limit of 3", quota)
+	}
+
+	mustCreateFavorite(t, c, "file3", "user")
+
+	quota, err = c.SetFavoriteQuota(ctx, "user", 0)
+	if err != nil {
+		t.Fatalf("SetFavoriteQuota() to 0 error = %v", err)
+	}
+
+	if quota.Usage != 3 || quota.Limit != 2 {
+		t.Errorf("SetFavoriteQuota() to 0 = %+v, want a usage of 3 and the default limit of 2", quota)
+	}
+
+	// An unlimited user may exceed the default limit.
+	quota, err = c.SetFavoriteQuota(ctx, "user", service.UnlimitedQuota)
+	if err != nil {
+		t.Fatalf("SetFavoriteQuota() to UnlimitedQuota error = %v", err)
+	}
+
+	if quota.Limit != service.UnlimitedQuota {
+		t.Errorf("SetFavoriteQuota() to UnlimitedQuota = %+v, want a limit of UnlimitedQuota", quota)
+	}
+
+	mustCreateFavorite(t, c, "file4", "user")
+
+	// The limit of a user without an override is UnlimitedQuota when there's no default limit.
+	unlimited := newTestController(t, time.Hour, 0)
+	if quota, err := unlimited.GetFavoriteQuota(ctx, "user"); err != nil || quota.Limit != service.UnlimitedQuota {
+		t.Errorf("GetFavoriteQuota() without a default limit = %+v, %v, want a limit of UnlimitedQuota", quota, err)
+	}
+
+}
+
+func TestControllerQuotaHiddenFavorites(t *testing.T) {
+	c := newTestController(t, time.Hour, 1)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "user")
+	if _, err := c.HandleFileEvent(ctx, service.FileEvent{Type: service.FileEventTrashed, FileID: "file1"}); err != nil {
+		t.Fatalf("HandleFileEvent() error = %v", err)
+	}
+
+	_, err := c.CreateFavorite(ctx, service.ItemTypeFile, "file2", "user", time.Time{}, false)
+	assertKind(t, err, service.KindResourceExhausted)
+
+	quota, err := c.GetFavoriteQuota(ctx, "user")
+	if err != nil {
+		t.Fatalf("GetFavoriteQuota() error = %v", err)
+	}
+
+	if quota.Usage != 1 {
+		t.Errorf("GetFavoriteQuota() usage = %d, want the hidden favorite to count", quota.Usage)
+	}
+
+}
+
+func TestControllerQuotaRestore(t *testing.T) {
+	c := newTestController(t, time.Hour, 1)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "user")
+	if _, err := c.DeleteFavorite(ctx, service.ItemTypeFile, "file1", "user"); err != nil {
+		t.Fatalf("DeleteFavorite() error = %v", err)
+	}
+
+	mustCreateFavorite(t, c, "file2", "user")
+
+	_, err := c.RestoreFavorite(ctx, service.ItemTypeFile, "file1", "user")
+	assertKind(t, err, service.KindResourceExhausted)
+	assertActive(t, c, "user", "file2")
+
+	deleted, _, err := c.GetRecentlyDeletedFavorites(ctx, "user", service.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetRecentlyDeletedFavorites() error = %v", err)
+	}
+
+	if got := favoriteFileIDs(deleted); !equalStrings(got, []string{"file1"}) {
+		t.Errorf("GetRecentlyDeletedFavorites() fileIDs = %v, want the favorite that wasn't restored", got)
+	}
+
+}
+
+func TestControllerQuotaTransfer(t *testing.T) {
+	c := newTestController(t, time.Hour, 0)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "source")
+	mustCreateFavorite(t, c, "file2", "source")
+	if _, err := c.SetFavoriteQuota(ctx, "target", 1); err != nil {
+		t.Fatalf("SetFavoriteQuota() error = %v", err)
+	}
+
+	_, _, err := c.TransferFavorites(ctx, "source", "target", false)
+	assertKind(t, err, service.KindResourceExhausted)
+	assertActive(t, c, "target")
+	assertActive(t, c, "source", "file1", "file2")
+
+}
+
+func TestControllerQuotaConcurrentVersion(t *testing.T) {
+	quotaStore := &racingQuotaStore{QuotaStore: newQuotaStore(), races: 1}
+	c := newRacingController(quotaStore)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "user")
+	if quotaStore.increments != 2 {
+		t.Errorf("IncrementVersion() calls = %d, want a retry after the concurrent increment", quotaStore.increments)
+	}
+
+	quota, err := quotaStore.Get(ctx, "user")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if quota.Version != 2 {
+		t.Errorf("Get() version = %d, want 2", quota.Version)
+	}
+
+	assertActive(t, c, "user", "file1")
+
+}
+
+func TestControllerQuotaConcurrentVersionAborted(t *testing.T) {
+	quotaStore := &racingQuotaStore{QuotaStore: newQuotaStore(), races: service.MaxQuotaAttempts}
+	c := newRacingController(quotaStore)
+
+	_, err := c.CreateFavorite(context.Background(), service.ItemTypeFile, "file1", "user", time.Time{}, false)
+	assertKind(t, err, service.KindAborted)
+
+	// Every attempt is undone.
+	assertActive(t, c, "user")
+
+}
+
+func TestControllerQuotaConcurrentCreates(t *testing.T) {
+	const limit, creates = 5, 20
+	c := newTestController(t, time.Hour, limit)
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var created int64
+	var wg sync.WaitGroup
+	for i := 0; i < creates; i++ {
+		wg.Add(1)
+		go func(fileID string) {
+			defer wg.Done()
+
+			_, err := c.CreateFavorite(ctx, service.ItemTypeFile, fileID, "user", time.Time{}, false)
+			var serviceErr *service.Error
+			switch {
+			case err == nil:
+				mu.Lock()
+				created++
+				mu.Unlock()
+			case errors.As(err, &serviceErr) && (serviceErr.Kind == service.KindResourceExhausted || serviceErr.Kind == service.KindAborted):
+			default:
+				t.Errorf("CreateFavorite() error = %v, want a quota error", err)
+			}
+		}(string(rune('a' + i)))
+	}
+
+	wg.Wait()
+
+	quota, err := c.GetFavoriteQuota(ctx, "user")
+	if err != nil {
+		t.Fatalf("GetFavoriteQuota() error = %v", err)
+	}
+
+	if quota.Usage > limit || quota.Usage != created {
+		t.Errorf("GetFavoriteQuota() usage = %d after %d creates, want the created favorites within the limit of %d", quota.Usage, created, limit)
+	}
+
+}
+
+// eventRecorder is a service.EventPublisher that records the published events.
+type eventRecorder struct {
+	events []service.Event
+}
+
+// Publish records event.
+func (r *eventRecorder) Publish(ctx context.Context, event service.Event) error {
+	r.events = append(r.events, event)
+	return nil
+
+}
+
+// of returns the types of the recorded events of userID in order.
+func (r *eventRecorder) of(userID string) []string {
+	var eventTypes []string
+	for _, event := range r.events {
+		if event.UserID == userID {
+			eventTypes = append(eventTypes, event.Type+":"+event.ItemID)
+		}
+	}
+
+	return eventTypes
+
+}
+
+// relayAllEvents relays all of the events of c and returns the recorder of the relayed events.
+func relayAllEvents(t *testing.T, c service.Controller) *eventRecorder {
+	t.Helper()
+
+	recorder := &eventRecorder{}
+	for {
+		relayed, err := c.RelayEvents(context.Background(), recorder)
+		if err != nil {
+			t.Fatalf("RelayEvents() error = %v", err)
+		}
+
+		if relayed == 0 {
+			return recorder
+		}
+	}
+
+}
+
+// relayedEvents relays all of the events of c and returns the types of the relayed events of userID in order.
+func relayedEvents(t *testing.T, c service.Controller, userID string) []string {
+	t.Helper()
+
+	return relayAllEvents(t, c).of(userID)
+
+}
+
+func TestControllerRestore(t *testing.T) {
+	c := newTestController(t, time.Hour, 0)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "user")
+	if _, err := c.DeleteFavorite(ctx, service.ItemTypeFile, "file1", "user"); err != nil {
+		t.Fatalf("DeleteFavorite() error = %v", err)
+	}
+
+	assertActive(t, c, "user")
+
+	deleted, _, err := c.GetRecentlyDeletedFavorites(ctx, "user", service.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetRecentlyDeletedFavorites() error = %v", err)
+	}
+
+	if got := favoriteFileIDs(deleted); !equalStrings(got, []string{"file1"}) {
+		t.Errorf("GetRecentlyDeletedFavorites() fileIDs = %v, want [file1]", got)
+	}
+
+	restored, err := c.RestoreFavorite(ctx, service.ItemTypeFile, "file1", "user")
+	if err != nil {
+		t.Fatalf("RestoreFavorite() error = %v", err)
+	}
+
+	if !restored.GetDeletedAt().IsZero() {
+		t.Errorf("RestoreFavorite() deletedAt = %v, want none", restored.GetDeletedAt())
+	}
+
+	assertActive(t, c, "user", "file1")
+
+	_, err = c.RestoreFavorite(ctx, service.ItemTypeFile, "file1", "user")
+	assertKind(t, err, service.KindNotFound)
+
+	_, err = c.RestoreFavorite(ctx, service.ItemTypeFile, "missing", "user")
+	assertKind(t, err, service.KindNotFound)
+
+	want := []string{"FavoriteCreated:file1", "FavoriteDeleted:file1", "FavoriteCreated:file1"}
+	if got := relayedEvents(t, c, "user"); !equalStrings(got, want) {
+		t.Errorf("relayed events = %v, want %v", got, want)
+	}
+
+}
+
+func TestControllerUpdateEvents(t *testing.T) {
+	c := newTestController(t, time.Hour, 0)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "user")
+	mustCreateFavorite(t, c, "file2", "user")
+	if _, err := c.UpdateFavorite(ctx, service.ItemTypeFile, "file1", "user", service.FavoriteUpdate{Note: "note", SetNote: true}); err != nil {
+		t.Fatalf("UpdateFavorite() error = %v", err)
+	}
+
+	if _, err := c.ReorderFavorite(ctx, "user", service.ItemTypeFile, "file2", "", "file1"); err != nil {
+		t.Fatalf("ReorderFavorite() error = %v", err)
+	}
+
+	_, err := c.UpdateFavorite(ctx, service.ItemTypeFile, "missing", "user", service.FavoriteUpdate{Note: "note", SetNote: true})
+	assertKind(t, err, service.KindNotFound)
+
+	_, err = c.ReorderFavorite(ctx, "user", service.ItemTypeFile, "file2", "missing", "")
+	assertKind(t, err, service.KindNotFound)
+
+	want := []string{"FavoriteCreated:file1", "FavoriteCreated:file2", "FavoriteUpdated:file1", "FavoriteUpdated:file2"}
+	if got := relayedEvents(t, c, "user"); !equalStrings(got, want) {
+		t.Errorf("relayed events = %v, want %v", got, want)
+	}
+
+}
+
+func TestControllerBatchDeleteRestore(t *testing.T) {
+	c := newTestController(t, time.Hour, 0)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "user")
+	results, err := c.DeleteFavorites(ctx, "user", service.ItemTypeFile, []string{"file1", "missing"})
+	if err != nil {
+		t.Fatalf("DeleteFavorites() error = %v", err)
+	}
+
+	if results[0].Status != service.BatchDeleted || results[1].Status != service.BatchNotFound {
+		t.Errorf("DeleteFavorites() = %+v, want file1 deleted and missing not found", results)
+	}
+
+	// The favorites of a batch delete may be restored like a single deleted favorite.
+	if _, err := c.RestoreFavorite(ctx, service.ItemTypeFile, "file1", "user"); err != nil {
+		t.Fatalf("RestoreFavorite() error = %v", err)
+	}
+
+	assertActive(t, c, "user", "file1")
+
+}
+
+func TestControllerRestoreWindow(t *testing.T) {
+	const restoreWindow = 20 * time.Millisecond
+	c := newTestController(t, restoreWindow, 0)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "user")
+	mustCreateFavorite(t, c, "file2", "user")
+	if _, err := c.DeleteFavorite(ctx, service.ItemTypeFile, "file1", "user"); err != nil {
+		t.Fatalf("DeleteFavorite() error = %v", err)
+	}
+
+	time.Sleep(2 * restoreWindow)
+
+	if _, err := c.DeleteFavorite(ctx, service.ItemTypeFile, "file2", "user"); err != nil {
+		t.Fatalf("DeleteFavorite() error = %v", err)
+	}
+
+	_, err := c.RestoreFavorite(ctx, service.ItemTypeFile, "file1", "user")
+	assertKind(t, err, service.KindNotFound)
+
+	deleted, _, err := c.GetRecentlyDeletedFavorites(ctx, "user", service.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetRecentlyDeletedFavorites() error = %v", err)
+	}
+
+	if got := favoriteFileIDs(deleted); !equalStrings(got, []string{"file2"}) {
+		t.Errorf("GetRecentlyDeletedFavorites() fileIDs = %v, want only the favorite deleted within the window", got)
+	}
+
+	purgedCount, err := c.PurgeDeletedFavorites(ctx)
+	if err != nil {
+		t.Fatalf("PurgeDeletedFavorites() error = %v", err)
+	}
+
+	if purgedCount != 1 {
+		t.Errorf("PurgeDeletedFavorites() = %d, want 1", purgedCount)
+	}
+
+	// The favorite deleted within the window isn't purged and may still be restored.
+	if _, err := c.RestoreFavorite(ctx, service.ItemTypeFile, "file2", "user"); err != nil {
+		t.Fatalf("RestoreFavorite() error = %v", err)
+	}
+
+	if purgedCount, err := c.PurgeDeletedFavorites(ctx); err != nil || purgedCount != 0 {
+		t.Errorf("PurgeDeletedFavorites() again = %d, %v, want 0", purgedCount, err)
+	}
+
+	// A purged favorite may be created again.
+	mustCreateFavorite(t, c, "file1", "user")
+	assertActive(t, c, "user", "file1", "file2")
+
+	want := []string{
+		"FavoriteCreated:file1", "FavoriteCreated:file2", "FavoriteDeleted:file1", "FavoriteDeleted:file2",
+		"FavoritePurged:file1", "FavoriteCreated:file2", "FavoriteCreated:file1",
+	}
+	if got := relayedEvents(t, c, "user"); !equalStrings(got, want) {
+		t.Errorf("relayed events = %v, want %v", got, want)
+	}
+
+}
+
+func TestControllerExpireFavorites(t *testing.T) {
+	const restoreWindow = 50 * time.Millisecond
+	c := newTestController(t, restoreWindow, 0)
+	ctx := context.Background()
+
+	if _, err := c.CreateFavorite(ctx, service.ItemTypeFile, "file1", "user", time.Now().Add(10*time.Millisecond), false); err != nil {
+		t.Fatalf("CreateFavorite() of an expiring favorite error = %v", err)
+	}
+
+	mustCreateFavorite(t, c, "file2", "user")
+	time.Sleep(20 * time.Millisecond)
+
+	expiredCount, err := c.ExpireFavorites(ctx)
+	if err != nil {
+		t.Fatalf("ExpireFavorites() error = %v", err)
+	}
+
+	if expiredCount != 1 {
+		t.Errorf("ExpireFavorites() = %d, want 1", expiredCount)
+	}
+
+	if expiredCount, err := c.ExpireFavorites(ctx); err != nil || expiredCount != 0 {
+		t.Errorf("ExpireFavorites() again = %d, %v, want 0", expiredCount, err)
+	}
+
+	// An expired favorite is deleted like a favorite deleted by its user.
+	deleted, _, err := c.GetRecentlyDeletedFavorites(ctx, "user", service.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetRecentlyDeletedFavorites() error = %v", err)
+	}
+
+	if got := favoriteFileIDs(deleted); !equalStrings(got, []string{"file1"}) {
+		t.Errorf("GetRecentlyDeletedFavorites() fileIDs = %v, want [file1]", got)
+	}
+
+	time.Sleep(2 * restoreWindow)
+
+	if purgedCount, err := c.PurgeDeletedFavorites(ctx); err != nil || purgedCount != 1 {
+		t.Errorf("PurgeDeletedFavorites() = %d, %v, want 1", purgedCount, err)
+	}
+
+	assertActive(t, c, "user", "file2")
+
+	want := []string{"FavoriteCreated:file1", "FavoriteCreated:file2", "FavoriteDeleted:file1", "FavoritePurged:file1"}
+	if got := relayedEvents(t, c, "user"); !equalStrings(got, want) {
+		t.Errorf("relayed events = %v, want %v", got, want)
+	}
+
+}
+
+func TestControllerWatchBulkChanges(t *testing.T) {
+	c := newTestController(t, time.Hour, 0)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "user")
+	mustCreateFavorite(t, c, "file2", "user")
+	mustCreateFavorite(t, c, "file3", "user")
+	mustCreateFavorite(t, c, "file4", "other")
+
+	want := []string{
+		"DELETED:file1", "CREATED:file1", "DELETED:file2", "CREATED:file4",
+		"DELETED:file1", "DELETED:file3", "DELETED:file4",
+	}
+
+	watchCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	watched := make(chan []string, 1)
+	go func() {
+		var changes []string
+		c.WatchFavorites(watchCtx, "user", "", func(change service.Change) error {
+			changes = append(changes, change.Type+":"+change.Favorite.GetFileID())
+			if len(changes) == len(want) {
+				cancel()
+			}
+
+			return nil
+		})
+
+		watched <- changes
+	}()
+
+	// Lets the watcher subscribe before the changes are made.
+	time.Sleep(20 * time.Millisecond)
+
+	for _, event := range []service.FileEvent{
+		{Type: service.FileEventTrashed, FileID: "file1"},
+		{Type: service.FileEventRestored, FileID: "file1"},
+		{Type: service.FileEventDeleted, FileID: "file2"},
+	} {
+		if _, err := c.HandleFileEvent(ctx, event); err != nil {
+			t.Fatalf("HandleFileEvent(%+v) error = %v", event, err)
+		}
+	}
+
+	if _, _, err := c.TransferFavorites(ctx, "other", "user", false); err != nil {
+		t.Fatalf("TransferFavorites() error = %v", err)
+	}
+
+	if _, err := c.DeleteAllUserFavorites(ctx, "user", "test", "admin"); err != nil {
+		t.Fatalf("DeleteAllUserFavorites() error = %v", err)
+	}
+
+	if got := <-watched; !equalStrings(got, want) {
+		t.Errorf("WatchFavorites() changes = %v, want %v", got, want)
+	}
+
+}
+
+func TestControllerHandleFileEvent(t *testing.T) {
+	c := newTestController(t, time.Hour, 0)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "user")
+	mustCreateFavorite(t, c, "file1", "other")
+	mustCreateFavorite(t, c, "file2", "user")
+
+	trashed := service.FileEvent{Type: service.FileEventTrashed, FileID: "file1"}
+	if hiddenCount, err := c.HandleFileEvent(ctx, trashed); err != nil || hiddenCount != 2 {
+		t.Fatalf("HandleFileEvent() of a trashed file = %d, %v, want 2", hiddenCount, err)
+	}
+
+	assertActive(t, c, "user", "file2")
+
+	// A hidden favorite already exists, so it isn't replaced.
+	_, err := c.CreateFavorite(ctx, service.ItemTypeFile, "file1", "user", time.Time{}, false)
+	assertKind(t, err, service.KindConflict)
+
+	existing, err := c.CreateFavorite(ctx, service.ItemTypeFile, "file1", "user", time.Time{}, true)
+	if err != nil {
+		t.Fatalf("CreateFavorite() of a hidden favorite with idempotent error = %v", err)
+	}
+
+	if existing.GetFileID() != "file1" {
+		t.Errorf("CreateFavorite() = %s, want the hidden favorite", existing.GetFileID())
+	}
+
+	restored := service.FileEvent{Type: service.FileEventRestored, FileID: "file1"}
+	if shownCount, err := c.HandleFileEvent(ctx, restored); err != nil || shownCount != 2 {
+		t.Fatalf("HandleFileEvent() of a restored file = %d, %v, want 2", shownCount, err)
+	}
+
+	assertActive(t, c, "user", "file1", "file2")
+
+	deleted := service.FileEvent{Type: service.FileEventDeleted, FileID: "file1"}
+	if deletedCount, err := c.HandleFileEvent(ctx, deleted); err != nil || deletedCount != 2 {
+		t.Fatalf("HandleFileEvent() of a deleted file = %d, %v, want 2", deletedCount, err)
+	}
+
+	assertActive(t, c, "user", "file2")
+	assertActive(t, c, "other")
+
+	want := []string{
+		"FavoriteCreated:file1", "FavoriteCreated:file2", "FavoriteDeleted:file1",
+		"FavoriteCreated:file1", "FavoriteDeleted:file1",
+	}
+	if got := relayedEvents(t, c, "user"); !equalStrings(got, want) {
+		t.Errorf("relayed events = %v, want %v", got, want)
+	}
+
+}
+
+func TestControllerReconcileOrphans(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       service.ReconcileOptions
+		reconciled int64
+		active     []string
+		events     []string
+	}{
+		{
+			name:   "DryRun",
+			opts:   service.ReconcileOptions{Action: service.ReconcileDelete, DryRun: true},
+			active: []string{"file1", "file2", "file3"},
+		},
+		{
+			name:       "Delete",
+			opts:       service.ReconcileOptions{Action: service.ReconcileDelete},
+			reconciled: 2,
+			active:     []string{"file1", "file3"},
+			events:     []string{"FavoriteDeleted:file2"},
+		},
+		{
+			name:       "Flag",
+			opts:       service.ReconcileOptions{Action: service.ReconcileFlag},
+			reconciled: 2,
+			active:     []string{"file1", "file3"},
+			events:     []string{"FavoriteDeleted:file2"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestController(t, time.Hour, 0)
+			ctx := context.Background()
+
+			mustCreateFavorite(t, c, "file1", "user")
+			mustCreateFavorite(t, c, "file2", "user")
+			mustCreateFavorite(t, c, "file3", "user")
+			mustCreateFavorite(t, c, "file2", "other")
+			relayedEvents(t, c, "user")
+
+			report, err := c.ReconcileOrphans(ctx, checker.NewStaticChecker("file1", "file3"), tt.opts)
+			if err != nil {
+				t.Fatalf("ReconcileOrphans() error = %v", err)
+			}
+
+			if report.ScannedFavorites != 4 || report.CheckedFiles != 3 || report.OrphanedFiles != 1 || report.OrphanedFavorites != 2 {
+				t.Errorf("ReconcileOrphans() report = %+v, want 4 scanned favorites of 3 files with 1 orphaned file of 2 favorites", report)
+			}
+
+			if report.ReconciledFavorites != tt.reconciled || report.DryRun != tt.opts.DryRun || report.Action != tt.opts.Action {
+				t.Errorf("ReconcileOrphans() report = %+v, want %d reconciled favorites", report, tt.reconciled)
+			}
+
+			assertActive(t, c, "user", tt.active...)
+			if got := relayedEvents(t, c, "user"); !equalStrings(got, tt.events) {
+				t.Errorf("relayed events = %v, want %v", got, tt.events)
+			}
+		})
+	}
+
+}
+
+func TestControllerReconcileOrphansFlagRestored(t *testing.T) {
+	c := newTestController(t, time.Hour, 0)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "user")
+	mustCreateFavorite(t, c, "file2", "user")
+
+	opts := service.ReconcileOptions{Action: service.ReconcileFlag}
+	if _, err := c.ReconcileOrphans(ctx, checker.NewStaticChecker("file1"), opts); err != nil {
+		t.Fatalf("ReconcileOrphans() error = %v", err)
+	}
+
+	assertActive(t, c, "user", "file1")
+
+	// A flagged favorite is shown again once its file is restored.
+	restored := service.FileEvent{Type: service.FileEventRestored, FileID: "file2"}
+	if shownCount, err := c.HandleFileEvent(ctx, restored); err != nil || shownCount != 1 {
+		t.Fatalf("HandleFileEvent() of a restored file = %d, %v, want 1", shownCount, err)
+	}
+
+	assertActive(t, c, "user", "file1", "file2")
+
+}
+
+func TestControllerReconcileOrphansUnflag(t *testing.T) {
+	c := newTestController(t, time.Hour, 0)
+	ctx := context.Background()
+
+	mustCreateFavorite(t, c, "file1", "user")
+	mustCreateFavorite(t, c, "file2", "user")
+	mustCreateFavorite(t, c, "file3", "user")
+	mustCreateFavorite(t, c, "file4", "user")
+
+	opts := service.ReconcileOptions{Action: service.ReconcileFlag}
+	files := checker.NewStaticChecker("file1", "file3", "file4")
+	if _, err := c.ReconcileOrphans(ctx, files, opts); err != nil {
+		t.Fatalf("ReconcileOrphans() error = %v", err)
+	}
+
+	trashed := service.FileEvent{Type: service.FileEventTrashed, FileID: "file3"}
+	if _, err := c.HandleFileEvent(ctx, trashed); err != nil {
+		t.Fatalf("HandleFileEvent() error = %v", err)
+	}
+
+	if _, err := c.DeleteFavorite(ctx, service.ItemTypeFile, "file4", "user"); err != nil {
+		t.Fatalf("DeleteFavorite() error = %v", err)
+	}
+
+	relayedEvents(t, c, "user")
+
+	// Only the flagged favorite is shown again once its file is found, and only active favorites are scanned.
+	files.Add("file2")
+	files.Remove("file4")
+	report, err := c.ReconcileOrphans(ctx, files, opts)
+	if err != nil {
+		t.Fatalf("ReconcileOrphans() error = %v", err)
+	}
+
+	if report.UnflaggedFavorites != 1 || report.OrphanedFavorites != 0 {
+		t.Errorf("ReconcileOrphans() report = %+v, want 1 unflagged favorite and no orphaned favorites", report)
+	}
+
+	assertActive(t, c, "user", "file1", "file2")
+	if got, want := relayedEvents(t, c, "user"), []string{"FavoriteCreated:file2"}; !equalStrings(got, want) {
+		t.Errorf("relayed events = %v, want %v", got, want)
+	}
+
+}
+
+func TestControllerReconcileOrphansUnknownAction(t *testing.T) {
+	c := newTestController(t, time.Hour, 0)
+	mustCreateFavorite(t, c, "file1", "user")
+
+	opts := service.ReconcileOptions{Action: "archive"}
+	if _, err := c.ReconcileOrphans(context.Background(), checker.NewStaticChecker(), opts); err == nil {
+		t.Fatal("ReconcileOrphans() of an unknown action error = nil, want an error")
+	}
+
+	assertActive(t, c, "user", "file1")
+
+}
+
+func TestControllerTransferFavorites(t *testing.T) {
+	tests := []struct {
+		name         string
+		keepSource   bool
+		source       []string
+		sourceEvents []string
+	}{
+		{name: "Move", keepSource: false, source: nil, sourceEvents: []string{"FavoriteDeleted:file1", "FavoriteDeleted:file2"}},
+		{name: "KeepSource", keepSource: true, source: []string{"file1", "file2"}, sourceEvents: nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestController(t, time.Hour, 0)
+			ctx := context.Background()
+
+			mustCreateFavorite(t, c, "file1", "source")
+			mustCreateFavorite(t, c, "file2", "source")
+			mustCreateFavorite(t, c, "file2", "target")
+			relayAllEvents(t, c)
+
+			movedCount, skippedCount, err := c.TransferFavorites(ctx, "source", "target", tt.keepSource)
+			if err != nil || movedCount != 1 || skippedCount != 1 {
+				t.Fatalf("TransferFavorites() = %d, %d, %v, want 1 moved and 1 skipped", movedCount, skippedCount, err)
+			}
+
+			assertActive(t, c, "target", "file1", "file2")
+			assertActive(t, c, "source", tt.source...)
+
+			recorder := relayAllEvents(t, c)
+			if got, want := recorder.of("target"), []string{"FavoriteCreated:file1"}; !equalStrings(got, want) {
+				t.Errorf("relayed events of target = %v, want %v", got, want)
+			}
+
+			if got := recorder.of("source"); !equalStrings(got, tt.sourceEvents) {
+				t.Errorf("relayed events of source = %v, want %v", got, tt.sourceEvents)
+			}
+		})
+	}
+
+}
+
+func TestControllerTransferFavoritesKeepsFields(t *testing.T) {
+	c := newTestController(t, time.Hour, 0)
+	ctx := context.Background()
+
+	source := mustCreateFavorite(t, c, "file1", "source")
+	update := service.FavoriteUpdate{
+		Tags: []string{"work"}, SetTags: true,
+		Note: "quarterly report", SetNote: true,
+		Rank: "a", SetRank: true,
+		Pinned: true, SetPinned: true,
+	}
+	if _, err := c.UpdateFavorite(ctx, service.ItemTypeFile, "file1", "source", update); err != nil {
+		t.Fatalf("UpdateFavorite() error = %v", err)
+	}
+
+	if _, _, err := c.TransferFavorites(ctx, "source", "target", false); err != nil {
+		t.Fatalf("TransferFavorites() error = %v", err)
+	}
+
+	favorites, _, err := c.GetAllFavorites(ctx, "target", service.FavoriteQuery{}, service.ListOptions{})
+	if err != nil || len(favorites) != 1 {
+		t.Fatalf("GetAllFavorites() = %d favorites, %v, want the transferred favorite", len(favorites), err)
+	}
+
+	transferred := favorites[0]
+	if transferred.GetUserID() != "target" || !transferred.GetCreatedAt().Equal(source.GetCreatedAt()) {
+		t.Errorf("transferred favorite = %s created at %v, want target created at %v",
+			transferred.GetUserID(), transferred.GetCreatedAt(), source.GetCreatedAt())
+	}
+
+	if !equalStrings(transferred.GetTags(), update.Tags) || transferred.GetNote() != update.Note {
+		t.Errorf("transferred favorite tags = %v note = %q, want %v and %q", transferred.GetTags(), transferred.GetNote(), update.Tags, update.Note)
+	}
+
+	if transferred.GetRank() != update.Rank || !transferred.GetPinned() {
+		t.Errorf("transferred favorite rank = %q pinned = %v, want %q pinned", transferred.GetRank(), transferred.GetPinned(), update.Rank)
+	}
+
+}
+
+func TestControllerCreateFavoriteIdempotent(t *testing.T) {
+	c := newTestController(t, time.Hour, 0)
+	ctx := context.Background()
+
+	created := mustCreateFavorite(t, c, "file1", "user")
+
+	_, err := c.CreateFavorite(ctx, service.ItemTypeFile, "file1", "user", time.Time{}, false)
+	assertKind(t, err, service.KindConflict)
+
+	existing, err := c.CreateFavorite(ctx, service.ItemTypeFile, "file1", "user", time.Time{}, true)
+	if err != nil {
+		t.Fatalf("CreateFavorite() of an existing favorite with idempotent error = %v", err)
+	}
+
+	if existing.GetFileID() != "file1" || !existing.GetCreatedAt().Equal(created.GetCreatedAt()) {
+		t.Errorf("CreateFavorite() = %s created at %v, want the existing favorite created at %v",
+			existing.GetFileID(), existing.GetCreatedAt(), created.GetCreatedAt())
+	}
+
+	// Returning the existing favorite doesn't create it again.
+	if got, want := relayedEvents(t, c, "user"), []string{"FavoriteCreated:file1"}; !equalStrings(got, want) {
+		t.Errorf("relayed events = %v, want %v", got, want)
+	}
+
+}
+
+func TestControllerCreateFavoriteReplacesInactive(t *testing.T) {
+	c := newTestController(t, time.Hour, 0)
+	ctx := context.Background()
+
+	if _, err := c.CreateFavorite(ctx, service.ItemTypeFile, "expired", "user", time.Now().Add(10*time.Millisecond), false); err != nil {
+		t.Fatalf("CreateFavorite() of an expiring favorite error = %v", err)
+	}
+
+	mustCreateFavorite(t, c, "deleted", "user")
+	if _, err := c.DeleteFavorite(ctx, service.ItemTypeFile, "deleted", "user"); err != nil {
+		t.Fatalf("DeleteFavorite() error = %v", err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	assertActive(t, c, "user")
+
+	// An inactive favorite is replaced whether or not the create is idempotent.
+	for fileID, idempotent := range map[string]bool{"expired": false, "deleted": true} {
+		favorite, err := c.CreateFavorite(ctx, service.ItemTypeFile, fileID, "user", time.Time{}, idempotent)
+		if err != nil {
+			t.Fatalf("CreateFavorite() of the %s favorite error = %v", fileID, err)
+		}
+
+		if !favorite.GetExpiresAt().IsZero() || !favorite.GetDeletedAt().IsZero() {
+			t.Errorf("CreateFavorite() of the %s favorite expires at %v deleted at %v, want a new favorite",
+				fileID, favorite.GetExpiresAt(), favorite.GetDeletedAt())
+		}
+	}
+
+	assertActive(t, c, "user", "deleted", "expired")
+
+}
@@ -0,0 +1,181 @@
+package memory
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/meateam/fav-service/proto"
+	"github.com/meateam/fav-service/service"
+)
+
+// Favorite is the structure that represents a favorite as it's stored in memory.
+type Favorite struct {
+	// id is the sequence number of the favorite in its store, used for paging.
+	id uint64
+
+	// FileID is the ID of the favorited item of any ItemType, named after files
+	// which were the only items that could be favorited.
+	FileID    string
+	ItemType  string
+	UserID    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// CollectionIDs is replaced rather than modified in place, since copies of f share it.
+	CollectionIDs []string
+
+	// Tags is replaced rather than modified in place, since copies of f share it.
+	Tags   []string
+	Note   string
+	Rank   string
+	Pinned bool
+
+	// ExpiresAt is the zero time if f never expires.
+	ExpiresAt time.Time
+
+	// DeletedAt is the zero time if f wasn't deleted.
+	DeletedAt time.Time
+
+	// Hidden is set while the favorited file is in the trash or missing.
+	Hidden bool
+
+	// Orphaned is set while f is hidden since the favorited file is missing.
+	Orphaned bool
+}
+
+// GetItemType returns f.ItemType, or service.ItemTypeFile if f has no item type.
+func (f Favorite) GetItemType() string {
+	if f.ItemType == "" {
+		return service.ItemTypeFile
+	}
+
+	return f.ItemType
+
+}
+
+// GetItemID returns f.FileID, which holds the ID of the item of any type.
+func (f Favorite) GetItemID() string {
+	return f.FileID
+
+}
+
+// GetFileID returns f.FileID if f is a favorite of a file, or an empty string otherwise.
+func (f Favorite) GetFileID() string {
+	if f.GetItemType() != service.ItemTypeFile {
+		return ""
+	}
+
+	return f.FileID
+
+}
+
+// SetFileID sets f.FileID to fileID.
+func (f *Favorite) SetFileID(fileID string) error {
+	if f == nil {
+		panic("f == nil")
+	}
+
+	if fileID == "" {
+		return fmt.Errorf("FileID is required")
+	}
+
+	f.FileID = fileID
+	f.ItemType = service.ItemTypeFile
+	return nil
+
+}
+
+// GetUserID returns f.UserID.
+func (f Favorite) GetUserID() string {
+	return f.UserID
+
+}
+
+// SetUserID sets f.UserID to userID.
+func (f *Favorite) SetUserID(userID string) error {
+	if f == nil {
+		panic("f == nil")
+	}
+
+	if userID == "" {
+		return fmt.Errorf("UserID is required")
+	}
+
+	f.UserID = userID
+	return nil
+
+}
+
+// GetCreatedAt returns f.CreatedAt.
+func (f Favorite) GetCreatedAt() time.Time {
+	return f.CreatedAt
+
+}
+
+// GetUpdatedAt returns f.UpdatedAt.
+func (f Favorite) GetUpdatedAt() time.Time {
+	return f.UpdatedAt
+
+}
+
+// GetCollectionIDs returns f.CollectionIDs.
+func (f Favorite) GetCollectionIDs() []string {
+	return f.CollectionIDs
+
+}
+
+// GetTags returns f.Tags.
+func (f Favorite) GetTags() []string {
+	return f.Tags
+
+}
+
+// GetNote returns f.Note.
+func (f Favorite) GetNote() string {
+	return f.Note
+
+}
+
+// GetRank returns f.Rank.
+func (f Favorite) GetRank() string {
+	return f.Rank
+
+}
+
+// GetPinned returns f.Pinned.
+func (f Favorite) GetPinned() bool {
+	return f.Pinned
+
+}
+
+// GetExpiresAt returns f.ExpiresAt.
+func (f Favorite) GetExpiresAt() time.Time {
+	return f.ExpiresAt
+
+}
+
+// GetDeletedAt returns f.DeletedAt.
+func (f Favorite) GetDeletedAt() time.Time {
+	return f.DeletedAt
+
+}
+
+// MarshalProto marshals f into a favorite.
+func (f Favorite) MarshalProto(favorite *pb.FavoriteObject) error {
+	favorite.FileID = f.GetFileID()
+	favorite.ItemType = f.GetItemType()
+	favorite.ItemID = f.GetItemID()
+	favorite.UserID = f.GetUserID()
+	favorite.CreatedAt = service.UnixMillis(f.GetCreatedAt())
+	favorite.UpdatedAt = service.UnixMillis(f.GetUpdatedAt())
+	favorite.CollectionIDs = f.GetCollectionIDs()
+	favorite.Tags = f.GetTags()
+	favorite.Note = f.GetNote()
+	favorite.Rank = f.GetRank()
+	favorite.Pinned = f.GetPinned()
+	favorite.ExpiresAt = service.UnixMillis(f.GetExpiresAt())
+	favorite.DeletedAt = service.UnixMillis(f.GetDeletedAt())
+
+	return nil
+
+}
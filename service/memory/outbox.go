@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+)
+
+// Outbox must implement service.Outbox.
+var _ service.Outbox = (*Outbox)(nil)
+
+// outboxEvent is an event that wasn't published yet along with the end of the lease of its claim.
+type outboxEvent struct {
+	event        service.Event
+	claimedUntil time.Time
+}
+
+// Outbox holds the events that weren't published yet in memory and implements Outbox interface.
+// Each event gets an increasing id as its ID, and events are kept in the order they were added.
+type Outbox struct {
+	mu     sync.Mutex
+	events []*outboxEvent
+	lastID uint64
+}
+
+// newOutbox returns a new empty outbox.
+func newOutbox() *Outbox {
+	return &Outbox{}
+
+}
+
+// Add stores events to be published, setting their IDs. It never fails, so the memory controller
+// may add the events of its mutations after storing them.
+func (o *Outbox) Add(ctx context.Context, events []service.Event) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i := range events {
+		o.lastID++
+		events[i].ID = strconv.FormatUint(o.lastID, 10)
+		o.events = append(o.events, &outboxEvent{event: events[i]})
+	}
+
+	return nil
+
+}
+
+// Claim claims up to limit stored events that aren't claimed, oldest first, for lease.
+func (o *Outbox) Claim(ctx context.Context, limit int, lease time.Duration) ([]service.Event, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	now := time.Now()
+	claimed := make([]service.Event, 0, limit)
+	for _, stored := range o.events {
+		if len(claimed) == limit {
+			break
+		}
+
+		if stored.claimedUntil.After(now) {
+			continue
+		}
+
+		stored.claimedUntil = now.Add(lease)
+		claimed = append(claimed, stored.event)
+	}
+
+	return claimed, nil
+
+}
+
+// Delete deletes the events of ids after they were published.
+func (o *Outbox) Delete(ctx context.Context, ids []string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	deleted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		deleted[id] = true
+	}
+
+	kept := o.events[:0]
+	for _, stored := range o.events {
+		if !deleted[stored.event.ID] {
+			kept = append(kept, stored)
+		}
+	}
+
+	for i := len(kept); i < len(o.events); i++ {
+		o.events[i] = nil
+	}
+
+	o.events = kept
+
+	return nil
+
+}
@@ -0,0 +1,137 @@
+package memory
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+)
+
+// pageToken is the position of the last favorite of a page in the sort order of the listing.
+type pageToken struct {
+	Sort      service.SortOrder `json:"s"`
+	ID        uint64            `json:"i"`
+	CreatedAt int64             `json:"c,omitempty"`
+	FileID    string            `json:"f,omitempty"`
+	Rank      string            `json:"r,omitempty"`
+	Pinned    bool              `json:"p,omitempty"`
+	DeletedAt int64             `json:"d,omitempty"`
+}
+
+// less returns a function reporting whether favorite a comes before favorite b in order.
+// Favorites with the same sort key are ordered by their id.
+func less(order service.SortOrder) (func(a *Favorite, b *Favorite) bool, error) {
+	switch order {
+	case service.SortOldestFirst:
+		return func(a *Favorite, b *Favorite) bool {
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.Before(b.CreatedAt)
+			}
+
+			return a.id < b.id
+		}, nil
+	case service.SortNewestFirst:
+		return func(a *Favorite, b *Favorite) bool {
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.After(b.CreatedAt)
+			}
+
+			return a.id > b.id
+		}, nil
+	case service.SortByFileID:
+		return func(a *Favorite, b *Favorite) bool {
+			if a.FileID != b.FileID {
+				return a.FileID < b.FileID
+			}
+
+			return a.id < b.id
+		}, nil
+	case service.SortManual:
+		return func(a *Favorite, b *Favorite) bool {
+			if a.Pinned != b.Pinned {
+				return a.Pinned
+			}
+
+			if a.Rank != b.Rank {
+				return a.Rank < b.Rank
+			}
+
+			return a.id < b.id
+		}, nil
+	case service.SortRecentlyDeleted:
+		return func(a *Favorite, b *Favorite) bool {
+			if !a.DeletedAt.Equal(b.DeletedAt) {
+				return a.DeletedAt.After(b.DeletedAt)
+			}
+
+			return a.id > b.id
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown sort order %d", order)
+	}
+
+}
+
+// sortFavorites sorts favorites in order.
+func sortFavorites(favorites []*Favorite, order service.SortOrder) error {
+	lessFunc, err := less(order)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(favorites, func(i int, j int) bool {
+		return lessFunc(favorites[i], favorites[j])
+	})
+
+	return nil
+
+}
+
+// encodePageToken encodes the position of last, the last favorite of a page listed in order.
+func encodePageToken(order service.SortOrder, last *Favorite) string {
+	token := pageToken{Sort: order, ID: last.id}
+
+	switch order {
+	case service.SortOldestFirst, service.SortNewestFirst:
+		token.CreatedAt = last.CreatedAt.UnixNano()
+	case service.SortByFileID:
+		token.FileID = last.FileID
+	case service.SortManual:
+		token.Rank = last.Rank
+		token.Pinned = last.Pinned
+	case service.SortRecentlyDeleted:
+		token.DeletedAt = last.DeletedAt.UnixNano()
+	}
+
+	encoded, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+
+}
+
+// decodePageToken decodes encodedToken and returns the position of the last favorite of
+// the previous page, as a favorite holding only the fields that favorites are ordered by.
+// Returns service.ErrInvalidPageToken if encodedToken is malformed or wasn't returned for order.
+func decodePageToken(order service.SortOrder, encodedToken string) (*Favorite, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encodedToken)
+	if err != nil {
+		return nil, service.ErrInvalidPageToken
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(decoded, &token); err != nil || token.Sort != order {
+		return nil, service.ErrInvalidPageToken
+	}
+
+	return &Favorite{
+		id:        token.ID,
+		FileID:    token.FileID,
+		CreatedAt: time.Unix(0, token.CreatedAt).UTC(),
+		Rank:      token.Rank,
+		Pinned:    token.Pinned,
+		DeletedAt: time.Unix(0, token.DeletedAt).UTC(),
+	}, nil
+
+}
@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/meateam/fav-service/service"
+)
+
+// QuotaStore must implement service.QuotaStore.
+var _ service.QuotaStore = (*QuotaStore)(nil)
+
+// QuotaStore holds the favorite quotas of users in memory and implements QuotaStore interface.
+type QuotaStore struct {
+	mu     sync.RWMutex
+	quotas map[string]service.Quota
+}
+
+// newQuotaStore returns a new empty quota store.
+func newQuotaStore() *QuotaStore {
+	return &QuotaStore{quotas: make(map[string]service.Quota)}
+
+}
+
+// Get returns the quota of userID, or a quota with no limit and a version of 0 if none is stored.
+func (s *QuotaStore) Get(ctx context.Context, userID string) (service.Quota, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.get(userID), nil
+
+}
+
+// IncrementVersion increments the version of the quota of userID if it's still version,
+// and returns false if it has changed since.
+func (s *QuotaStore) IncrementVersion(ctx context.Context, userID string, version int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	quota := s.get(userID)
+	if quota.Version != version {
+		return false, nil
+	}
+
+	quota.Version++
+	s.quotas[userID] = quota
+
+	return true, nil
+
+}
+
+// SetLimit sets the limit override of userID, a limit of 0 removes the override.
+func (s *QuotaStore) SetLimit(ctx context.Context, userID string, limit int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	quota := s.get(userID)
+	quota.Limit = limit
+	s.quotas[userID] = quota
+
+	return nil
+
+}
+
+// Delete deletes the quota of userID along with its limit override.
+func (s *QuotaStore) Delete(ctx context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.quotas, userID)
+
+	return nil
+
+}
+
+// get returns the quota of userID, s.mu must be held.
+func (s *QuotaStore) get(userID string) service.Quota {
+	quota, ok := s.quotas[userID]
+	if !ok {
+		return service.Quota{UserID: userID}
+	}
+
+	return quota
+
+}
@@ -0,0 +1,582 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+)
+
+// Store must implement service.Store.
+var _ service.Store = (*Store)(nil)
+
+// Filter is the filter type accepted by Store, empty fields match any value.
+// FileID and FileIDs match the item IDs of favorites of any item type.
+type Filter struct {
+	FileID   string
+	UserID   string
+	ItemType string
+
+	// FileIDs matches favorites with any of FileIDs, a non-nil empty FileIDs matches no favorite.
+	FileIDs []string
+
+	// CollectionID matches favorites in the collection of CollectionID.
+	CollectionID string
+
+	// Tag matches favorites tagged with Tag.
+	Tag string
+
+	// ActiveAt matches favorites that haven't expired at ActiveAt, weren't deleted and aren't hidden.
+	ActiveAt time.Time
+
+	// IncludeHidden makes ActiveAt match hidden favorites too.
+	IncludeHidden bool
+
+	// Hidden matches only hidden favorites.
+	Hidden bool
+
+	// Orphaned matches only favorites hidden as orphaned.
+	Orphaned bool
+
+	// InactiveAt matches favorites that have expired at InactiveAt or were deleted, whether or not they're hidden.
+	InactiveAt time.Time
+
+	// ExpiredAt matches favorites that have expired at ExpiredAt and weren't deleted, whether or not they're hidden.
+	ExpiredAt time.Time
+
+	// DeletedAfter matches favorites deleted after DeletedAfter.
+	DeletedAfter time.Time
+
+	// DeletedBefore matches favorites deleted at or before DeletedBefore.
+	DeletedBefore time.Time
+}
+
+// match returns true if favorite matches all of the non-empty fields of f.
+func (f Filter) match(favorite *Favorite) bool {
+	if f.FileID != "" && f.FileID != favorite.FileID {
+		return false
+	}
+
+	if f.UserID != "" && f.UserID != favorite.UserID {
+		return false
+	}
+
+	if f.ItemType != "" && f.ItemType != favorite.GetItemType() {
+		return false
+	}
+
+	// A nil FileIDs matches any favorite, while an empty one matches none.
+	if f.FileIDs != nil && (len(f.FileIDs) == 0 || !contains(f.FileIDs, favorite.FileID)) {
+		return false
+	}
+
+	if f.CollectionID != "" && !contains(favorite.CollectionIDs, f.CollectionID) {
+		return false
+	}
+
+	if f.Tag != "" && !contains(favorite.Tags, f.Tag) {
+		return false
+	}
+
+	if !f.ActiveAt.IsZero() && (service.Expired(favorite, f.ActiveAt) || !favorite.DeletedAt.IsZero() || (favorite.Hidden && !f.IncludeHidden)) {
+		return false
+	}
+
+	if f.Hidden && !favorite.Hidden {
+		return false
+	}
+
+	if f.Orphaned && !favorite.Orphaned {
+		return false
+	}
+
+	if !f.InactiveAt.IsZero() && !service.Expired(favorite, f.InactiveAt) && favorite.DeletedAt.IsZero() {
+		return false
+	}
+
+	if !f.ExpiredAt.IsZero() && (!service.Expired(favorite, f.ExpiredAt) || !favorite.DeletedAt.IsZero()) {
+		return false
+	}
+
+	if !f.DeletedAfter.IsZero() && !favorite.DeletedAt.After(f.DeletedAfter) {
+		return false
+	}
+
+	if !f.DeletedBefore.IsZero() && (favorite.DeletedAt.IsZero() || favorite.DeletedAt.After(f.DeletedBefore)) {
+		return false
+	}
+
+	return true
+
+}
+
+// Store holds the favorites in memory and implements Store interface.
+// Each favorite gets an increasing id, which orders favorites created at the same time.
+type Store struct {
+	mu        sync.RWMutex
+	favorites []*Favorite
+	lastID    uint64
+}
+
+// newStore returns a new empty store.
+func newStore() *Store {
+	return &Store{}
+
+}
+
+// GetAll gets all favorites that match filter ordered by opts.Sort, filter must be a Filter.
+// If there are no matching favorites, it will return an empty array.
+// If opts.PageSize is set, the favorites are paged using the sort key and id of the last
+// favorite of the page as the next page token.
+func (s *Store) GetAll(ctx context.Context, filter interface{}, opts service.ListOptions) ([]service.Favorite, string, error) {
+	f, err := toFilter(filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	lessFunc, err := less(opts.Sort)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var after *Favorite
+	if opts.PageToken != "" {
+		if after, err = decodePageToken(opts.Sort, opts.PageToken); err != nil {
+			return nil, "", err
+		}
+	}
+
+	s.mu.RLock()
+	matched := []*Favorite{}
+	for _, favorite := range s.favorites {
+		if f.match(favorite) && (after == nil || lessFunc(after, favorite)) {
+			copied := *favorite
+			matched = append(matched, &copied)
+		}
+	}
+	s.mu.RUnlock()
+
+	if err := sortFavorites(matched, opts.Sort); err != nil {
+		return nil, "", err
+	}
+
+	nextPageToken := ""
+	if opts.PageSize > 0 && len(matched) > opts.PageSize {
+		matched = matched[:opts.PageSize]
+		nextPageToken = encodePageToken(opts.Sort, matched[len(matched)-1])
+	}
+
+	favorites := make([]service.Favorite, 0, len(matched))
+	for _, favorite := range matched {
+		favorites = append(favorites, favorite)
+	}
+
+	return favorites, nextPageToken, nil
+
+}
+
+// ForEach calls fn for each favorite that matches filter in sort order, filter must be a Filter.
+// The matching favorites are copied before iterating, so fn may modify the store.
+func (s *Store) ForEach(ctx context.Context, filter interface{}, sort service.SortOrder, fn func(service.Favorite) error) error {
+	favorites, _, err := s.GetAll(ctx, filter, service.ListOptions{Sort: sort})
+	if err != nil {
+		return err
+	}
+
+	for _, favorite := range favorites {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := fn(favorite); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+// Count returns the number of favorites that match filter, filter must be a Filter.
+func (s *Store) Count(ctx context.Context, filter interface{}) (int64, error) {
+	f, err := toFilter(filter)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var count int64
+	for _, favorite := range s.favorites {
+		if f.match(favorite) {
+			count++
+		}
+	}
+
+	return count, nil
+
+}
+
+// Create creates a favorite object of userID, item type and item ID.
+// If favorite already exists then it will return nil and service.ErrAlreadyExists.
+// If successful returns the favorite object and a nil error.
+func (s *Store) Create(ctx context.Context, favorite service.Favorite) (service.Favorite, error) {
+	itemType := favorite.GetItemType()
+	itemID := favorite.GetItemID()
+	userID := favorite.GetUserID()
+
+	if itemID == "" {
+		return nil, fmt.Errorf("itemID is required")
+	}
+
+	if userID == "" {
+		return nil, fmt.Errorf("userID is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.find(Filter{FileID: itemID, UserID: userID, ItemType: itemType}) != -1 {
+		return nil, service.ErrAlreadyExists
+	}
+
+	// Times are kept in millisecond precision, same as mongodb dates.
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	s.lastID++
+	created := Favorite{
+		id:        s.lastID,
+		FileID:    itemID,
+		ItemType:  itemType,
+		UserID:    userID,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Tags:      append([]string(nil), favorite.GetTags()...),
+		Note:      favorite.GetNote(),
+		Rank:      favorite.GetRank(),
+		Pinned:    favorite.GetPinned(),
+	}
+	if !favorite.GetCreatedAt().IsZero() {
+		created.CreatedAt = favorite.GetCreatedAt().UTC().Truncate(time.Millisecond)
+	}
+
+	if !favorite.GetExpiresAt().IsZero() {
+		created.ExpiresAt = favorite.GetExpiresAt().UTC().Truncate(time.Millisecond)
+	}
+
+	if !favorite.GetDeletedAt().IsZero() {
+		created.DeletedAt = favorite.GetDeletedAt().UTC().Truncate(time.Millisecond)
+	}
+
+	if created.Rank == "" {
+		created.Rank = service.DefaultRank(created.CreatedAt, fmt.Sprintf("%016x", created.id))
+	}
+	s.favorites = append(s.favorites, &created)
+
+	copied := created
+	return &copied, nil
+
+}
+
+// CreateMany creates each of favorites, a favorite that fails doesn't prevent the others
+// from being created. Returns the error of each favorite.
+func (s *Store) CreateMany(ctx context.Context, favorites []service.Favorite) ([]error, error) {
+	errs := make([]error, len(favorites))
+	for i, favorite := range favorites {
+		_, errs[i] = s.Create(ctx, favorite)
+	}
+
+	return errs, nil
+
+}
+
+// Delete deletes the first favorite that matches filter, filter must be a Filter.
+// If favorite does not exists it will return nil and service.ErrNotFound.
+// If successful returns the deleted favorite object.
+func (s *Store) Delete(ctx context.Context, filter interface{}) (service.Favorite, error) {
+	f, err := toFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.find(f)
+	if i == -1 {
+		return nil, service.ErrNotFound
+	}
+
+	deleted := *s.favorites[i]
+	s.favorites = append(s.favorites[:i], s.favorites[i+1:]...)
+
+	return &deleted, nil
+
+}
+
+// DeleteMany deletes all favorites that match filter, filter must be a Filter.
+// If successful returns the number of deleted favorites.
+func (s *Store) DeleteMany(ctx context.Context, filter interface{}) (int64, error) {
+	f, err := toFilter(filter)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.favorites[:0]
+	for _, favorite := range s.favorites {
+		if !f.match(favorite) {
+			kept = append(kept, favorite)
+		}
+	}
+
+	deleted := int64(len(s.favorites) - len(kept))
+	for i := len(kept); i < len(s.favorites); i++ {
+		s.favorites[i] = nil
+	}
+
+	s.favorites = kept
+
+	return deleted, nil
+
+}
+
+// Update applies update to the first favorite that matches filter, filter must be a Filter.
+// If favorite does not exists it will return nil and service.ErrNotFound.
+// If successful returns the updated favorite object.
+func (s *Store) Update(ctx context.Context, filter interface{}, update service.FavoriteUpdate) (service.Favorite, error) {
+	f, err := toFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.find(f)
+	if i == -1 {
+		return nil, service.ErrNotFound
+	}
+
+	favorite := s.favorites[i]
+	applyUpdate(favorite, update, time.Now().UTC().Truncate(time.Millisecond))
+
+	copied := *favorite
+	return &copied, nil
+
+}
+
+// UpdateMany applies update to all favorites that match filter, filter must be a Filter.
+// Returns the number of matching favorites.
+func (s *Store) UpdateMany(ctx context.Context, filter interface{}, update service.FavoriteUpdate) (int64, error) {
+	f, err := toFilter(filter)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	var matched int64
+	for _, favorite := range s.favorites {
+		if f.match(favorite) {
+			applyUpdate(favorite, update, now)
+			matched++
+		}
+	}
+
+	return matched, nil
+
+}
+
+// applyUpdate applies update to favorite and sets its update time to now.
+func applyUpdate(favorite *Favorite, update service.FavoriteUpdate, now time.Time) {
+	if update.SetTags {
+		favorite.Tags = append([]string{}, update.Tags...)
+	}
+
+	if update.SetNote {
+		favorite.Note = update.Note
+	}
+
+	if update.SetRank {
+		favorite.Rank = update.Rank
+	}
+
+	if update.SetPinned {
+		favorite.Pinned = update.Pinned
+	}
+
+	if update.SetDeletedAt {
+		favorite.DeletedAt = update.DeletedAt.UTC().Truncate(time.Millisecond)
+	}
+
+	favorite.UpdatedAt = now
+
+}
+
+// AddToCollections adds collectionIDs to the collections of all favorites that match filter,
+// filter must be a Filter. Returns the number of matching favorites.
+func (s *Store) AddToCollections(ctx context.Context, filter interface{}, collectionIDs []string) (int64, error) {
+	return s.updateCollections(filter, func(favoriteCollectionIDs []string) []string {
+		updated := append([]string{}, favoriteCollectionIDs...)
+		for _, collectionID := range collectionIDs {
+			if !contains(updated, collectionID) {
+				updated = append(updated, collectionID)
+			}
+		}
+
+		return updated
+	})
+
+}
+
+// RemoveFromCollections removes collectionIDs from the collections of all favorites that match filter,
+// filter must be a Filter. Returns the number of matching favorites.
+func (s *Store) RemoveFromCollections(ctx context.Context, filter interface{}, collectionIDs []string) (int64, error) {
+	return s.updateCollections(filter, func(favoriteCollectionIDs []string) []string {
+		updated := []string{}
+		for _, collectionID := range favoriteCollectionIDs {
+			if !contains(collectionIDs, collectionID) {
+				updated = append(updated, collectionID)
+			}
+		}
+
+		return updated
+	})
+
+}
+
+// updateCollections replaces the collections of all favorites that match filter with the result
+// of update and sets their update time. Returns the number of matching favorites.
+func (s *Store) updateCollections(filter interface{}, update func([]string) []string) (int64, error) {
+	f, err := toFilter(filter)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	var matched int64
+	for _, favorite := range s.favorites {
+		if f.match(favorite) {
+			favorite.CollectionIDs = update(favorite.CollectionIDs)
+			favorite.UpdatedAt = now
+			matched++
+		}
+	}
+
+	return matched, nil
+
+}
+
+// SetHidden sets whether all favorites that match filter are hidden and sets their update time,
+// filter must be a Filter. Returns the number of matching favorites.
+func (s *Store) SetHidden(ctx context.Context, filter interface{}, hidden bool) (int64, error) {
+	f, err := toFilter(filter)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	var matched int64
+	for _, favorite := range s.favorites {
+		if f.match(favorite) {
+			favorite.Hidden = hidden
+			favorite.Orphaned = false
+			favorite.UpdatedAt = now
+			matched++
+		}
+	}
+
+	return matched, nil
+
+}
+
+// SetOrphaned sets whether all favorites that match filter are hidden as orphaned and sets their update time,
+// filter must be a Filter. Returns the number of matching favorites.
+func (s *Store) SetOrphaned(ctx context.Context, filter interface{}, orphaned bool) (int64, error) {
+	f, err := toFilter(filter)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	var matched int64
+	for _, favorite := range s.favorites {
+		if f.match(favorite) {
+			favorite.Hidden = orphaned
+			favorite.Orphaned = orphaned
+			favorite.UpdatedAt = now
+			matched++
+		}
+	}
+
+	return matched, nil
+
+}
+
+// HealthCheck always returns true since the store has no external dependencies.
+func (s *Store) HealthCheck(ctx context.Context) (bool, error) {
+	return true, nil
+
+}
+
+// find returns the index of the first favorite that matches f, or -1 if there is none.
+// s.mu must be held by the caller.
+func (s *Store) find(f Filter) int {
+	for i, favorite := range s.favorites {
+		if f.match(favorite) {
+			return i
+		}
+	}
+
+	return -1
+
+}
+
+// contains returns true if values contains value.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+
+}
+
+// toFilter converts filter to a Filter.
+func toFilter(filter interface{}) (Filter, error) {
+	switch f := filter.(type) {
+	case Filter:
+		return f, nil
+	case *Filter:
+		if f == nil {
+			return Filter{}, nil
+		}
+
+		return *f, nil
+	default:
+		return Filter{}, fmt.Errorf("unsupported filter type %T", filter)
+	}
+
+}
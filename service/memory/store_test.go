@@ -0,0 +1,27 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/meateam/fav-service/service"
+	"github.com/meateam/fav-service/service/storetest"
+)
+
+func TestStore(t *testing.T) {
+	storetest.Run(t, storetest.Harness{
+		NewStore: func(t *testing.T) service.Store {
+			return newStore()
+		},
+		Filter: filterBuilder{},
+		NewCollectionStore: func(t *testing.T) service.CollectionStore {
+			return newCollectionStore()
+		},
+		NewQuotaStore: func(t *testing.T) service.QuotaStore {
+			return newQuotaStore()
+		},
+		NewOutbox: func(t *testing.T) service.Outbox {
+			return newOutbox()
+		},
+	})
+
+}
@@ -0,0 +1,48 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// AuditCollectionName is the name of the audit records collection.
+const AuditCollectionName = "favoriteAudit"
+
+// AuditBSON is the structure that represents an audit record as it's stored.
+type AuditBSON struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	Action       string             `bson:"action"`
+	UserID       string             `bson:"userID"`
+	Reason       string             `bson:"reason,omitempty"`
+	RequestedBy  string             `bson:"requestedBy,omitempty"`
+	DeletedCount int64              `bson:"deletedCount"`
+	CreatedAt    time.Time          `bson:"createdAt"`
+}
+
+// MongoAuditStore must implement service.AuditStore.
+var _ service.AuditStore = MongoAuditStore{}
+
+// MongoAuditStore holds the mongodb database and implements AuditStore interface.
+type MongoAuditStore struct {
+	DB *mongo.Database
+}
+
+// Create stores record in the audit collection.
+func (s MongoAuditStore) Create(ctx context.Context, record service.AuditRecord) error {
+	collection := s.DB.Collection(AuditCollectionName)
+
+	_, err := collection.InsertOne(ctx, &AuditBSON{
+		Action:       record.Action,
+		UserID:       record.UserID,
+		Reason:       record.Reason,
+		RequestedBy:  record.RequestedBy,
+		DeletedCount: record.DeletedCount,
+		CreatedAt:    record.CreatedAt,
+	})
+
+	return toServiceError(err)
+}
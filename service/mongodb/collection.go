@@ -0,0 +1,228 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// CollectionCollectionName is the name of the favorite collections collection.
+	CollectionCollectionName = "favoriteCollection"
+
+	// CollectionBSONUserIDField is the name of the userID field in CollectionBSON.
+	CollectionBSONUserIDField = "userID"
+
+	// CollectionBSONNameField is the name of the name field in CollectionBSON.
+	CollectionBSONNameField = "name"
+
+	// CollectionBSONUpdatedAtField is the name of the updatedAt field in CollectionBSON.
+	CollectionBSONUpdatedAtField = "updatedAt"
+)
+
+// CollectionBSON is the structure that represents a collection of favorites as it's stored.
+type CollectionBSON struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    string             `bson:"userID"`
+	Name      string             `bson:"name"`
+	CreatedAt time.Time          `bson:"createdAt"`
+	UpdatedAt time.Time          `bson:"updatedAt"`
+}
+
+// toCollection returns b as a service.Collection.
+func (b CollectionBSON) toCollection() service.Collection {
+	return service.Collection{
+		ID:        b.ID.Hex(),
+		UserID:    b.UserID,
+		Name:      b.Name,
+		CreatedAt: b.CreatedAt,
+		UpdatedAt: b.UpdatedAt,
+	}
+
+}
+
+// MongoCollectionStore must implement service.CollectionStore.
+var _ service.CollectionStore = MongoCollectionStore{}
+
+// MongoCollectionStore holds the mongodb database and implements CollectionStore interface.
+type MongoCollectionStore struct {
+	DB *mongo.Database
+}
+
+// newMongoCollectionStore returns a new collection store, creating the unique index of
+// the collection names of each user.
+func newMongoCollectionStore(db *mongo.Database) (MongoCollectionStore, error) {
+	collection := db.Collection(CollectionCollectionName)
+	_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			bson.E{
+				Key:   CollectionBSONUserIDField,
+				Value: 1,
+			},
+			bson.E{
+				Key:   CollectionBSONNameField,
+				Value: 1,
+			},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return MongoCollectionStore{}, err
+	}
+
+	return MongoCollectionStore{DB: db}, nil
+}
+
+// Create creates collection, returns service.ErrCollectionAlreadyExists if its user
+// already has a collection with the same name.
+func (s MongoCollectionStore) Create(ctx context.Context, collection service.Collection) (service.Collection, error) {
+	now := mongoTime(time.Now())
+	document := &CollectionBSON{
+		UserID:    collection.UserID,
+		Name:      collection.Name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	inserted, err := s.DB.Collection(CollectionCollectionName).InsertOne(ctx, document)
+	if mongo.IsDuplicateKeyError(err) {
+		return service.Collection{}, service.ErrCollectionAlreadyExists
+	}
+
+	if err != nil {
+		return service.Collection{}, toServiceError(err)
+	}
+
+	document.ID = inserted.InsertedID.(primitive.ObjectID)
+	return document.toCollection(), nil
+}
+
+// Get returns the collection of id and userID, or service.ErrCollectionNotFound if there is none.
+func (s MongoCollectionStore) Get(ctx context.Context, userID string, id string) (service.Collection, error) {
+	filter, err := collectionFilter(userID, id)
+	if err != nil {
+		return service.Collection{}, toServiceError(err)
+	}
+
+	return decodeCollection(s.DB.Collection(CollectionCollectionName).FindOne(ctx, filter))
+}
+
+// GetAll returns the collections of userID ordered by name.
+func (s MongoCollectionStore) GetAll(ctx context.Context, userID string) ([]service.Collection, error) {
+	cursor, err := s.DB.Collection(CollectionCollectionName).Find(
+		ctx,
+		bson.D{bson.E{Key: CollectionBSONUserIDField, Value: userID}},
+		options.Find().SetSort(bson.D{bson.E{Key: CollectionBSONNameField, Value: 1}}),
+	)
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	var documents []CollectionBSON
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, toServiceError(err)
+	}
+
+	collections := make([]service.Collection, 0, len(documents))
+	for _, document := range documents {
+		collections = append(collections, document.toCollection())
+	}
+
+	return collections, nil
+}
+
+// Rename sets the name of the collection of id and userID to name and returns it.
+func (s MongoCollectionStore) Rename(ctx context.Context, userID string, id string, name string) (service.Collection, error) {
+	filter, err := collectionFilter(userID, id)
+	if err != nil {
+		return service.Collection{}, toServiceError(err)
+	}
+
+	update := bson.D{
+		bson.E{
+			Key: "$set",
+			Value: bson.D{
+				bson.E{Key: CollectionBSONNameField, Value: name},
+				bson.E{Key: CollectionBSONUpdatedAtField, Value: mongoTime(time.Now())},
+			},
+		},
+	}
+
+	result := s.DB.Collection(CollectionCollectionName).FindOneAndUpdate(
+		ctx,
+		filter,
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	)
+
+	renamed, err := decodeCollection(result)
+	if mongo.IsDuplicateKeyError(err) {
+		return service.Collection{}, service.ErrCollectionAlreadyExists
+	}
+
+	return renamed, toServiceError(err)
+}
+
+// Delete deletes the collection of id and userID and returns it.
+func (s MongoCollectionStore) Delete(ctx context.Context, userID string, id string) (service.Collection, error) {
+	filter, err := collectionFilter(userID, id)
+	if err != nil {
+		return service.Collection{}, toServiceError(err)
+	}
+
+	return decodeCollection(s.DB.Collection(CollectionCollectionName).FindOneAndDelete(ctx, filter))
+}
+
+// DeleteAll deletes all of the collections of userID and returns the number of deleted collections.
+func (s MongoCollectionStore) DeleteAll(ctx context.Context, userID string) (int64, error) {
+	filter := bson.D{bson.E{Key: CollectionBSONUserIDField, Value: userID}}
+	result, err := s.DB.Collection(CollectionCollectionName).DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, toServiceError(err)
+	}
+
+	return result.DeletedCount, nil
+}
+
+// collectionFilter returns a filter matching the collection of id and userID.
+// Returns service.ErrCollectionNotFound if id isn't a valid collection ID.
+func collectionFilter(userID string, id string) (bson.D, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, service.ErrCollectionNotFound
+	}
+
+	return bson.D{
+		bson.E{
+			Key:   MongoObjectIDField,
+			Value: objectID,
+		},
+		bson.E{
+			Key:   CollectionBSONUserIDField,
+			Value: userID,
+		},
+	}, nil
+
+}
+
+// decodeCollection decodes the collection of result, or returns service.ErrCollectionNotFound
+// if result has no collection.
+func decodeCollection(result *mongo.SingleResult) (service.Collection, error) {
+	document := &CollectionBSON{}
+	err := result.Decode(document)
+	if err == mongo.ErrNoDocuments {
+		return service.Collection{}, service.ErrCollectionNotFound
+	}
+
+	if err != nil {
+		return service.Collection{}, toServiceError(err)
+	}
+
+	return document.toCollection(), nil
+
+}
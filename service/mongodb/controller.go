@@ -3,112 +3,91 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/meateam/fav-service/service"
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-
 )
 
-// Controller is the favorite service business logic implementation using MongoStore.
-type Controller struct {
-	store MongoStore
+// transactor must implement service.Transactor.
+var _ service.Transactor = transactor{}
+
+// transactor runs functions in transactions of the sessions of client.
+type transactor struct {
+	client *mongo.Client
 }
 
-// NewMongoController returns a new controller.
-func NewMongoController(db *mongo.Database) (Controller, error) {
-	store, err := newMongoStore(db)
+// WithTransaction runs fn in a transaction of a new session, which is retried on transient errors.
+// The errors of fn are converted to service errors only once the transaction is no longer retried,
+// since a transient error is only retried while it's the unwrapped mongo.CommandError.
+func (t transactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := t.client.StartSession()
 	if err != nil {
-		return Controller{}, err
+		return toServiceError(err)
 	}
+	defer session.EndSession(ctx)
 
-	return Controller{store: store}, nil
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, transactionError(fn(sessionCtx))
+	})
 
-}
+	return toServiceError(err)
 
+}
 
-// GetAllFavorites gets all user favorite files by userID
-func (c Controller) GetAllFavorites(ctx context.Context, userID string) ([]string, error) {
-	filter := bson.D{
-		bson.E{
-			Key:   FavoriteBSONUserIDField,
-			Value: userID,
-		},
+// NewMongoController returns a new controller, whose deleted favorites may be restored for restoreWindow
+// and whose users may have up to defaultQuota favorites unless their quota is overridden.
+// If publishEvents is set, the events of favorite mutations are stored in the outbox collection to be relayed.
+// If the deployment is a replica set or a sharded cluster, favorites are mutated in transactions along with
+// their events and their changes are watched with change streams, otherwise only the changes made by the
+// returned controller are watched. Publishing events requires a replica set or a sharded cluster, since
+// without transactions a mutation could be stored without its event.
+func NewMongoController(db *mongo.Database, restoreWindow time.Duration, defaultQuota int64, publishEvents bool) (service.StoreController, error) {
+	store, err := newMongoStore(db)
+	if err != nil {
+		return service.StoreController{}, err
 	}
 
-	favoriteFiles, err := c.store.GetAll(ctx, filter)
-	if err != nil && err != mongo.ErrNoDocuments {
-		return nil, err
+	collectionStore, err := newMongoCollectionStore(db)
+	if err != nil {
+		return service.StoreController{}, err
 	}
 
-	if err == mongo.ErrNoDocuments {
-		return nil, status.Error(codes.NotFound, "favorite not found")
+	quotaStore, err := newMongoQuotaStore(db)
+	if err != nil {
+		return service.StoreController{}, err
 	}
 
-	var returnedFavFiles []string
-
-	for _, fileob := range favoriteFiles {
-		returnedFavFiles = append(returnedFavFiles, fileob[2].Value.(string))
+	config := service.StoreControllerConfig{
+		Store:           store,
+		AuditStore:      MongoAuditStore{DB: db},
+		CollectionStore: collectionStore,
+		QuotaStore:      quotaStore,
+		Filters:         filterBuilder{},
+		RestoreWindow:   restoreWindow,
+		DefaultQuota:    defaultQuota,
 	}
 
-	return returnedFavFiles, nil
-
-}
-
-
-// CreateFavorite creates a Favorite in store and returns the created favorite.
-func (c Controller) CreateFavorite(ctx context.Context, fileID string, userID string,) (service.Favorite, error) {
-	FavoriteObject := &BSON{FileID: fileID, UserID: userID}
-	createdFavorite, err := c.store.Create(ctx, FavoriteObject)
+	transactions, err := supportsTransactions(context.Background(), db)
 	if err != nil {
-		return nil, fmt.Errorf("failed creating favorite: %v", err)
+		return service.StoreController{}, err
 	}
 
-	return createdFavorite, nil
-
-}
-
-
-// DeleteFavorite deletes the favorite in store that matches userID and fileID. 
-// returns the deleted favorite / error 
-func (c Controller) DeleteFavorite(ctx context.Context, fileID string, userID string,) (service.Favorite, error) {
-	filter := bson.D{
-		bson.E{
-			Key:   FavoriteBSONFileIDField,
-			Value: fileID,
-		},
-		bson.E{
-			Key:   FavoriteBSONUserIDField,
-			Value: userID,
-		},
+	if transactions {
+		config.Transactor = transactor{client: db.Client()}
+		config.Watcher = changeStreamWatcher{db: db}
 	}
 
-	favorite, err := c.store.Delete(ctx, filter)
-	if err != nil && err != mongo.ErrNoDocuments {
-		return nil, err
+	if publishEvents && !transactions {
+		return service.StoreController{}, fmt.Errorf("publishing events requires a replica set or a sharded cluster")
 	}
 
-	if err == mongo.ErrNoDocuments {
-		return nil, status.Error(codes.NotFound, "favorite not found")
+	if publishEvents {
+		if config.Outbox, err = newMongoOutbox(db); err != nil {
+			return service.StoreController{}, err
+		}
 	}
 
-	return favorite, nil
+	return service.NewStoreController(config), nil
 
 }
-
-// HealthCheck runs store's healthcheck and returns true if healthy, otherwise returns false
-// and any error if occurred.
-func (c Controller) HealthCheck(ctx context.Context) (bool, error) {
-	return c.store.HealthCheck(ctx)
-	
-}
-
-
-
-
-
-
-
-
@@ -0,0 +1,94 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestTransactionError(t *testing.T) {
+	transient := mongo.CommandError{Code: 112, Name: "WriteConflict", Labels: []string{"TransientTransactionError"}}
+	permanent := mongo.CommandError{Code: 2, Name: "BadValue"}
+
+	tests := []struct {
+		name          string
+		err           error
+		wantTransient bool
+	}{
+		{name: "transient", err: transient, wantTransient: true},
+		{name: "wrapped transient", err: fmt.Errorf("failed creating favorite: %w", transient), wantTransient: true},
+		{name: "service error of transient", err: service.NewUnavailableError(transient), wantTransient: true},
+		{name: "permanent", err: fmt.Errorf("failed creating favorite: %w", permanent)},
+		{name: "nil"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := transactionError(tt.err)
+			if _, ok := err.(mongo.CommandError); ok != tt.wantTransient {
+				t.Errorf("transactionError() = %#v, want an unwrapped mongo.CommandError = %v", err, tt.wantTransient)
+			}
+
+			if !tt.wantTransient && err != tt.err {
+				t.Errorf("transactionError() = %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+}
+
+func TestMongoControllerConcurrentQuotaCreates(t *testing.T) {
+	const creates = 2
+	db := newTestDatabase(t, connectTestMongo(t))
+	ctx := context.Background()
+
+	transactions, err := supportsTransactions(ctx, db)
+	if err != nil {
+		t.Fatalf("supportsTransactions() error = %v", err)
+	}
+
+	if !transactions {
+		t.Skip("the test mongodb doesn't support transactions")
+	}
+
+	c, err := NewMongoController(db, time.Hour, creates, false)
+	if err != nil {
+		t.Fatalf("NewMongoController() error = %v", err)
+	}
+
+	// Both creates increment the version of the quota of the user, so one of their transactions
+	// has a write conflict and must be retried rather than fail.
+	var wg sync.WaitGroup
+	errs := make([]error, creates)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			_, errs[i] = c.CreateFavorite(ctx, service.ItemTypeFile, fmt.Sprintf("file%d", i), "user", time.Time{}, false)
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("CreateFavorite(file%d) error = %v", i, err)
+		}
+	}
+
+	quota, err := c.GetFavoriteQuota(ctx, "user")
+	if err != nil {
+		t.Fatalf("GetFavoriteQuota() error = %v", err)
+	}
+
+	if quota.Usage != creates {
+		t.Errorf("GetFavoriteQuota() usage = %d, want %d", quota.Usage, creates)
+	}
+
+}
@@ -0,0 +1,46 @@
+package mongodb
+
+import (
+	"errors"
+
+	"github.com/meateam/fav-service/service"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+)
+
+// toServiceError converts the mongodb errors that clients may retry on into typed service errors,
+// timeouts into deadline errors and connection failures into unavailable errors.
+// Any other error is returned as is.
+func toServiceError(err error) error {
+	var serverSelectionErr topology.ServerSelectionError
+	var connectionErr topology.ConnectionError
+
+	switch {
+	case err == nil:
+		return nil
+	case mongo.IsTimeout(err):
+		return service.NewDeadlineError(err)
+	case mongo.IsNetworkError(err),
+		errors.As(err, &serverSelectionErr),
+		errors.As(err, &connectionErr),
+		errors.Is(err, mongo.ErrClientDisconnected):
+		return service.NewUnavailableError(err)
+	default:
+		return err
+	}
+
+}
+
+// transactionError returns the mongo.CommandError that err wraps if it's labeled as a transient transaction
+// error, since mongo.Session.WithTransaction only retries the transaction if its callback returns the
+// mongo.CommandError itself. Any other error is returned as is.
+func transactionError(err error) error {
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) && commandErr.HasErrorLabel(driver.TransientTransactionError) {
+		return commandErr
+	}
+
+	return err
+
+}
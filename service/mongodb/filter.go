@@ -0,0 +1,143 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/meateam/fav-service/service"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// filterBuilder must implement service.FilterBuilder.
+var _ service.FilterBuilder = filterBuilder{}
+
+// filterBuilder builds the filters of MongoStore.
+type filterBuilder struct{}
+
+// Filter returns the bson.D filter that matches the favorites matching filter.
+func (filterBuilder) Filter(filter service.FavoriteFilter) interface{} {
+	f := bson.D{}
+	if filter.UserID != "" {
+		f = append(f, bson.E{Key: FavoriteBSONUserIDField, Value: filter.UserID})
+	}
+
+	if filter.ItemType != "" {
+		f = append(f, bson.E{Key: FavoriteBSONItemTypeField, Value: filter.ItemType})
+	}
+
+	if filter.ItemIDs != nil {
+		f = append(f, bson.E{Key: FavoriteBSONFileIDField, Value: bson.D{bson.E{Key: "$in", Value: filter.ItemIDs}}})
+	}
+
+	if filter.CollectionID != "" {
+		f = append(f, bson.E{Key: FavoriteBSONCollectionIDsField, Value: filter.CollectionID})
+	}
+
+	if filter.Tag != "" {
+		f = append(f, bson.E{Key: FavoriteBSONTagsField, Value: filter.Tag})
+	}
+
+	if !filter.ActiveAt.IsZero() {
+		f = activeFilter(f, filter.ActiveAt, filter.IncludeHidden)
+	}
+
+	if filter.Hidden {
+		f = append(f, bson.E{Key: FavoriteBSONHiddenField, Value: true})
+	}
+
+	if filter.Orphaned {
+		f = append(f, bson.E{Key: FavoriteBSONOrphanedField, Value: true})
+	}
+
+	if !filter.InactiveAt.IsZero() {
+		f = inactiveFilter(f, filter.InactiveAt)
+	}
+
+	if !filter.ExpiredAt.IsZero() {
+		f = expiredFilter(f, filter.ExpiredAt)
+	}
+
+	if !filter.DeletedAfter.IsZero() {
+		f = deletedAfterFilter(f, filter.DeletedAfter)
+	}
+
+	if !filter.DeletedBefore.IsZero() {
+		f = deletedBeforeFilter(f, filter.DeletedBefore)
+	}
+
+	return f
+
+}
+
+// activeFilter returns filter narrowed down to the favorites that haven't expired at now,
+// including favorites that never expire, weren't deleted and aren't hidden unless includeHidden is set.
+func activeFilter(filter bson.D, now time.Time, includeHidden bool) bson.D {
+	filter = append(
+		filter,
+		bson.E{
+			Key:   FavoriteBSONExpiresAtField,
+			Value: bson.D{bson.E{Key: "$not", Value: bson.D{bson.E{Key: "$lte", Value: now}}}},
+		},
+		bson.E{
+			Key:   FavoriteBSONDeletedAtField,
+			Value: bson.D{bson.E{Key: "$exists", Value: false}},
+		},
+	)
+
+	if includeHidden {
+		return filter
+	}
+
+	return append(filter, bson.E{
+		Key:   FavoriteBSONHiddenField,
+		Value: bson.D{bson.E{Key: "$ne", Value: true}},
+	})
+
+}
+
+// inactiveFilter returns filter narrowed down to the favorites that have expired at now or were deleted,
+// hidden favorites are inactive only if they have expired or were deleted too.
+func inactiveFilter(filter bson.D, now time.Time) bson.D {
+	return append(filter, bson.E{
+		Key: "$or",
+		Value: bson.A{
+			bson.D{bson.E{Key: FavoriteBSONExpiresAtField, Value: bson.D{bson.E{Key: "$lte", Value: now}}}},
+			bson.D{bson.E{Key: FavoriteBSONDeletedAtField, Value: bson.D{bson.E{Key: "$exists", Value: true}}}},
+		},
+	})
+
+}
+
+// expiredFilter returns filter narrowed down to the favorites that have expired at now and weren't deleted,
+// whether or not they're hidden.
+func expiredFilter(filter bson.D, now time.Time) bson.D {
+	return append(
+		filter,
+		bson.E{
+			Key:   FavoriteBSONExpiresAtField,
+			Value: bson.D{bson.E{Key: "$lte", Value: now}},
+		},
+		bson.E{
+			Key:   FavoriteBSONDeletedAtField,
+			Value: bson.D{bson.E{Key: "$exists", Value: false}},
+		},
+	)
+
+}
+
+// deletedAfterFilter returns filter narrowed down to the favorites that were deleted after since.
+func deletedAfterFilter(filter bson.D, since time.Time) bson.D {
+	return append(filter, bson.E{
+		Key:   FavoriteBSONDeletedAtField,
+		Value: bson.D{bson.E{Key: "$gt", Value: since}},
+	})
+
+}
+
+// deletedBeforeFilter returns filter narrowed down to the favorites that were deleted at or before until.
+func deletedBeforeFilter(filter bson.D, until time.Time) bson.D {
+	return append(filter, bson.E{
+		Key:   FavoriteBSONDeletedAtField,
+		Value: bson.D{bson.E{Key: "$lte", Value: until}},
+	})
+
+}
@@ -0,0 +1,227 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/meateam/fav-service/service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// MigrationCollectionName is the name of the collection of the schema versions of the collections.
+	MigrationCollectionName = "favoriteMigration"
+
+	// MigrationBSONVersionField is the name of the version field in MigrationBSON.
+	MigrationBSONVersionField = "version"
+
+	// fileIDUserIDIndexName is the name of the unique index of favorites by fileID and userID,
+	// which was replaced by the unique index by fileID, userID and itemType.
+	fileIDUserIDIndexName = "fileID_1_userID_1"
+
+	// expiresAtTTLIndexName is the name of the TTL index that permanently deleted favorites once they expired,
+	// which was replaced by deleting expired favorites like the favorites deleted by users.
+	expiresAtTTLIndexName = "expiresAt_1"
+
+	// namespaceNotFoundCode is the code of the mongodb error of dropping an index of a collection that doesn't exist.
+	namespaceNotFoundCode = 26
+
+	// indexNotFoundCode is the code of the mongodb error of dropping an index that doesn't exist.
+	indexNotFoundCode = 27
+)
+
+// MigrationBSON is the structure that represents the schema version of a collection as it's stored,
+// the _id of the document is the name of the collection.
+type MigrationBSON struct {
+	ID      string `bson:"_id"`
+	Version int    `bson:"version"`
+}
+
+// migration migrates the documents or the indexes of a collection from its previous schema version.
+// Instances that start together may run the same migration, so migrations must be idempotent.
+type migration func(ctx context.Context, collection *mongo.Collection) error
+
+// favoriteMigrations are the migrations of the favorites collection in order, the schema version of the
+// collection is the number of migrations it went through. New migrations must only be appended.
+var favoriteMigrations = []migration{
+	migrateItemTypes,
+	dropFileIDUserIDIndex,
+	migrateCreatedAt,
+	migrateRanks,
+	dropExpiresAtTTLIndex,
+}
+
+// migrate runs the migrations of the collection of collectionName that it didn't go through yet,
+// and records its schema version after each of them. It runs before the indexes of the collection
+// are created, since a migration may drop an index that is replaced by an index of the same name.
+func migrate(ctx context.Context, db *mongo.Database, collectionName string, migrations []migration) error {
+	versions := db.Collection(MigrationCollectionName)
+	filter := bson.D{bson.E{Key: MongoObjectIDField, Value: collectionName}}
+
+	var document MigrationBSON
+	err := versions.FindOne(ctx, filter).Decode(&document)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return fmt.Errorf("failed reading the schema version of %s: %w", collectionName, err)
+	}
+
+	for version := document.Version; version < len(migrations); version++ {
+		if err := migrations[version](ctx, db.Collection(collectionName)); err != nil {
+			return fmt.Errorf("failed migrating %s to schema version %d: %w", collectionName, version+1, err)
+		}
+
+		update := bson.D{
+			bson.E{
+				Key:   "$max",
+				Value: bson.D{bson.E{Key: MigrationBSONVersionField, Value: version + 1}},
+			},
+		}
+		if _, err := versions.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true)); err != nil {
+			return fmt.Errorf("failed recording the schema version of %s: %w", collectionName, err)
+		}
+	}
+
+	return nil
+
+}
+
+// dropIndex drops the index of name of collection if it exists.
+func dropIndex(ctx context.Context, collection *mongo.Collection, name string) error {
+	_, err := collection.Indexes().DropOne(ctx, name)
+
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) && (commandErr.Code == indexNotFoundCode || commandErr.Code == namespaceNotFoundCode) {
+		return nil
+	}
+
+	return err
+
+}
+
+// migrateItemTypes sets the item type of the favorites created before item types were stored,
+// which are favorites of files.
+func migrateItemTypes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.UpdateMany(
+		ctx,
+		bson.D{
+			bson.E{
+				Key:   FavoriteBSONItemTypeField,
+				Value: bson.D{bson.E{Key: "$exists", Value: false}},
+			},
+		},
+		bson.D{
+			bson.E{
+				Key:   "$set",
+				Value: bson.D{bson.E{Key: FavoriteBSONItemTypeField, Value: service.ItemTypeFile}},
+			},
+		},
+	)
+
+	return err
+
+}
+
+// dropFileIDUserIDIndex drops the unique index by fileID and userID,
+// so the same item ID may be favorited once for each item type.
+func dropFileIDUserIDIndex(ctx context.Context, collection *mongo.Collection) error {
+	return dropIndex(ctx, collection, fileIDUserIDIndexName)
+
+}
+
+// migrateCreatedAt sets the creation and update times of the favorites created before createdAt and
+// updatedAt were stored to the creation time of their _id.
+func migrateCreatedAt(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.UpdateMany(
+		ctx,
+		bson.D{
+			bson.E{
+				Key:   FavoriteBSONCreatedAtField,
+				Value: bson.D{bson.E{Key: "$exists", Value: false}},
+			},
+		},
+		mongo.Pipeline{
+			bson.D{
+				bson.E{
+					Key: "$set",
+					Value: bson.D{
+						bson.E{Key: FavoriteBSONCreatedAtField, Value: bson.D{bson.E{Key: "$toDate", Value: "$" + MongoObjectIDField}}},
+						bson.E{Key: FavoriteBSONUpdatedAtField, Value: bson.D{bson.E{Key: "$toDate", Value: "$" + MongoObjectIDField}}},
+					},
+				},
+			},
+		},
+	)
+
+	return err
+
+}
+
+// migrateRanks sets the rank of the favorites created before ranks were stored to the service.DefaultRank
+// of their createdAt and _id, and unpins them.
+func migrateRanks(ctx context.Context, collection *mongo.Collection) error {
+	reversedMillis := bson.D{
+		bson.E{
+			Key: "$toString",
+			Value: bson.D{
+				bson.E{
+					Key:   "$subtract",
+					Value: bson.A{int64(service.MaxRankMillis), bson.D{bson.E{Key: "$toLong", Value: "$" + FavoriteBSONCreatedAtField}}},
+				},
+			},
+		},
+	}
+	paddedMillis := bson.D{
+		bson.E{
+			Key: "$substrCP",
+			Value: bson.A{
+				bson.D{bson.E{Key: "$concat", Value: bson.A{"0000000000000", reversedMillis}}},
+				bson.D{bson.E{Key: "$strLenCP", Value: reversedMillis}},
+				13,
+			},
+		},
+	}
+	_, err := collection.UpdateMany(
+		ctx,
+		bson.D{
+			bson.E{
+				Key:   FavoriteBSONRankField,
+				Value: bson.D{bson.E{Key: "$exists", Value: false}},
+			},
+		},
+		mongo.Pipeline{
+			bson.D{
+				bson.E{
+					Key: "$set",
+					Value: bson.D{
+						bson.E{
+							Key: FavoriteBSONRankField,
+							Value: bson.D{
+								bson.E{
+									Key:   "$concat",
+									Value: bson.A{paddedMillis, bson.D{bson.E{Key: "$toString", Value: "$" + MongoObjectIDField}}, "1"},
+								},
+							},
+						},
+						bson.E{
+							Key:   FavoriteBSONPinnedField,
+							Value: bson.D{bson.E{Key: "$ifNull", Value: bson.A{"$" + FavoriteBSONPinnedField, false}}},
+						},
+					},
+				},
+			},
+		},
+	)
+
+	return err
+
+}
+
+// dropExpiresAtTTLIndex drops the TTL index of expiresAt, since expired favorites are deleted by the
+// service so they may be restored and their deletion is published. The index that replaces it has the
+// same name, so it must only be dropped once.
+func dropExpiresAtTTLIndex(ctx context.Context, collection *mongo.Collection) error {
+	return dropIndex(ctx, collection, expiresAtTTLIndexName)
+
+}
@@ -0,0 +1,74 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestMigrate(t *testing.T) {
+	db := newTestDatabase(t, connectTestMongo(t))
+	ctx := context.Background()
+
+	// The favorites collection of a version before the expiresAt TTL index was dropped.
+	collection := db.Collection(FavoriteCollectionName)
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{bson.E{Key: FavoriteBSONExpiresAtField, Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		t.Fatalf("CreateOne() of the TTL index error = %v", err)
+	}
+
+	if _, err := collection.InsertOne(ctx, bson.D{bson.E{Key: FavoriteBSONFileIDField, Value: "file1"}}); err != nil {
+		t.Fatalf("InsertOne() error = %v", err)
+	}
+
+	if _, err := newMongoStore(db); err != nil {
+		t.Fatalf("newMongoStore() error = %v", err)
+	}
+
+	var favorite BSON
+	if err := collection.FindOne(ctx, bson.D{}).Decode(&favorite); err != nil {
+		t.Fatalf("FindOne() error = %v", err)
+	}
+
+	if favorite.ItemType == "" || favorite.CreatedAt.IsZero() || favorite.Rank == "" {
+		t.Errorf("migrated favorite = %+v, want its itemType, createdAt and rank set", favorite)
+	}
+
+	// Starting again doesn't run the migrations again, which would drop the index that replaced the TTL index.
+	if _, err := newMongoStore(db); err != nil {
+		t.Fatalf("newMongoStore() of a migrated database error = %v", err)
+	}
+
+	var version MigrationBSON
+	err = db.Collection(MigrationCollectionName).FindOne(ctx, bson.D{bson.E{Key: MongoObjectIDField, Value: FavoriteCollectionName}}).Decode(&version)
+	if err != nil {
+		t.Fatalf("FindOne() of the schema version error = %v", err)
+	}
+
+	if version.Version != len(favoriteMigrations) {
+		t.Errorf("schema version = %d, want %d", version.Version, len(favoriteMigrations))
+	}
+
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		t.Fatalf("List() of the indexes error = %v", err)
+	}
+
+	var indexes []bson.M
+	if err := cursor.All(ctx, &indexes); err != nil {
+		t.Fatalf("All() of the indexes error = %v", err)
+	}
+
+	for _, index := range indexes {
+		if _, ok := index["expireAfterSeconds"]; ok {
+			t.Errorf("index %v expires favorites, want no TTL index", index["name"])
+		}
+	}
+
+}
@@ -2,18 +2,66 @@ package mongodb
 
 import (
 	"fmt"
+	"time"
 
 	pb "github.com/meateam/fav-service/proto"
+	"github.com/meateam/fav-service/service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 // BSON is the structure that represents a favorite as it's stored.
 type BSON struct {
-	FileID    string             `bson:"fileID,omitempty"`
-	UserID    string             `bson:"userID,omitempty"`
+	ID primitive.ObjectID `bson:"_id,omitempty"`
+
+	// FileID is the ID of the favorited item of any ItemType, it's stored in the fileID
+	// field since files were the only items that could be favorited.
+	FileID    string    `bson:"fileID,omitempty"`
+	ItemType  string    `bson:"itemType"`
+	UserID    string    `bson:"userID,omitempty"`
+	CreatedAt time.Time `bson:"createdAt,omitempty"`
+	UpdatedAt time.Time `bson:"updatedAt,omitempty"`
+
+	CollectionIDs []string `bson:"collectionIDs,omitempty"`
+	Tags          []string `bson:"tags,omitempty"`
+	Note          string   `bson:"note,omitempty"`
+	Rank          string   `bson:"rank,omitempty"`
+	Pinned        bool     `bson:"pinned"`
+
+	// ExpiresAt is omitted for favorites that never expire, so the sparse index of expiresAt ignores them.
+	ExpiresAt time.Time `bson:"expiresAt,omitempty"`
+
+	// DeletedAt is omitted for favorites that weren't deleted.
+	DeletedAt time.Time `bson:"deletedAt,omitempty"`
+
+	// Hidden is set while the favorited file is in the trash or missing, and omitted otherwise.
+	Hidden bool `bson:"hidden,omitempty"`
+
+	// Orphaned is set while the favorite is hidden since the favorited file is missing, and omitted otherwise.
+	Orphaned bool `bson:"orphaned,omitempty"`
 }
 
-// GetFileID returns b.FileID.
+// GetItemType returns b.ItemType, or service.ItemTypeFile if b has no item type.
+func (b BSON) GetItemType() string {
+	if b.ItemType == "" {
+		return service.ItemTypeFile
+	}
+
+	return b.ItemType
+
+}
+
+// GetItemID returns b.FileID, which holds the ID of the item of any type.
+func (b BSON) GetItemID() string {
+	return b.FileID
+
+}
+
+// GetFileID returns b.FileID if b is a favorite of a file, or an empty string otherwise.
 func (b BSON) GetFileID() string {
+	if b.GetItemType() != service.ItemTypeFile {
+		return ""
+	}
+
 	return b.FileID
 
 }
@@ -29,6 +77,7 @@ func (b *BSON) SetFileID(fileID string) error {
 	}
 
 	b.FileID = fileID
+	b.ItemType = service.ItemTypeFile
 	return nil
 
 }
@@ -54,11 +103,76 @@ func (b *BSON) SetUserID(userID string) error {
 
 }
 
+// GetCreatedAt returns b.CreatedAt.
+func (b BSON) GetCreatedAt() time.Time {
+	return b.CreatedAt
+
+}
+
+// GetUpdatedAt returns b.UpdatedAt.
+func (b BSON) GetUpdatedAt() time.Time {
+	return b.UpdatedAt
+
+}
+
+// GetCollectionIDs returns b.CollectionIDs.
+func (b BSON) GetCollectionIDs() []string {
+	return b.CollectionIDs
+
+}
+
+// GetTags returns b.Tags.
+func (b BSON) GetTags() []string {
+	return b.Tags
+
+}
+
+// GetNote returns b.Note.
+func (b BSON) GetNote() string {
+	return b.Note
+
+}
+
+// GetRank returns b.Rank.
+func (b BSON) GetRank() string {
+	return b.Rank
+
+}
+
+// GetPinned returns b.Pinned.
+func (b BSON) GetPinned() bool {
+	return b.Pinned
+
+}
+
+// GetExpiresAt returns b.ExpiresAt.
+func (b BSON) GetExpiresAt() time.Time {
+	return b.ExpiresAt
+
+}
+
+// GetDeletedAt returns b.DeletedAt.
+func (b BSON) GetDeletedAt() time.Time {
+	return b.DeletedAt
+
+}
+
 // MarshalProto marshals b into a favorite.
 func (b BSON) MarshalProto(favorite *pb.FavoriteObject) error {
 	favorite.FileID = b.GetFileID()
+	favorite.ItemType = b.GetItemType()
+	favorite.ItemID = b.GetItemID()
 	favorite.UserID = b.GetUserID()
+	favorite.CreatedAt = service.UnixMillis(b.GetCreatedAt())
+	favorite.UpdatedAt = service.UnixMillis(b.GetUpdatedAt())
+	favorite.CollectionIDs = b.GetCollectionIDs()
+	favorite.Tags = b.GetTags()
+	favorite.Note = b.GetNote()
+	favorite.Rank = b.GetRank()
+	favorite.Pinned = b.GetPinned()
+	favorite.ExpiresAt = service.UnixMillis(b.GetExpiresAt())
+	favorite.DeletedAt = service.UnixMillis(b.GetDeletedAt())
 
 	return nil
 
-}
\ No newline at end of file
+}
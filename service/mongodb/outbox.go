@@ -0,0 +1,217 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// OutboxCollectionName is the name of the collection of the events that weren't published yet.
+	OutboxCollectionName = "favoriteOutbox"
+
+	// OutboxBSONClaimedUntilField is the name of the claimedUntil field in OutboxBSON.
+	OutboxBSONClaimedUntilField = "claimedUntil"
+
+	// OutboxBSONClaimIDField is the name of the claimID field in OutboxBSON.
+	OutboxBSONClaimIDField = "claimID"
+)
+
+// OutboxBSON is the structure that represents an event that wasn't published yet as it's stored.
+type OutboxBSON struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	Type       string             `bson:"type"`
+	UserID     string             `bson:"userID"`
+	ItemType   string             `bson:"itemType"`
+	ItemID     string             `bson:"itemID"`
+	OccurredAt time.Time          `bson:"occurredAt"`
+
+	// ClaimedUntil is the end of the lease of the relay that claimed the event, the zero time if unclaimed.
+	ClaimedUntil time.Time `bson:"claimedUntil"`
+
+	// ClaimID identifies the last claim of the event.
+	ClaimID primitive.ObjectID `bson:"claimID,omitempty"`
+}
+
+// toEvent returns b as a service.Event.
+func (b OutboxBSON) toEvent() service.Event {
+	return service.Event{
+		ID:         b.ID.Hex(),
+		Type:       b.Type,
+		UserID:     b.UserID,
+		ItemType:   b.ItemType,
+		ItemID:     b.ItemID,
+		OccurredAt: b.OccurredAt,
+	}
+
+}
+
+// MongoOutbox must implement service.Outbox.
+var _ service.Outbox = MongoOutbox{}
+
+// MongoOutbox holds the mongodb database and implements Outbox interface.
+// Events are identified by their ObjectIDs, so they're claimed roughly in the order they were added.
+type MongoOutbox struct {
+	DB *mongo.Database
+}
+
+// newMongoOutbox returns a new outbox, creating the index of the unclaimed events in order.
+func newMongoOutbox(db *mongo.Database) (MongoOutbox, error) {
+	collection := db.Collection(OutboxCollectionName)
+	_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			bson.E{
+				Key:   OutboxBSONClaimedUntilField,
+				Value: 1,
+			},
+			bson.E{
+				Key:   "_id",
+				Value: 1,
+			},
+		},
+	})
+	if err != nil {
+		return MongoOutbox{}, err
+	}
+
+	return MongoOutbox{DB: db}, nil
+}
+
+// Add stores events to be published, setting their IDs.
+func (o MongoOutbox) Add(ctx context.Context, events []service.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, 0, len(events))
+	for i := range events {
+		id := primitive.NewObjectID()
+		events[i].ID = id.Hex()
+		documents = append(documents, &OutboxBSON{
+			ID:         id,
+			Type:       events[i].Type,
+			UserID:     events[i].UserID,
+			ItemType:   events[i].ItemType,
+			ItemID:     events[i].ItemID,
+			OccurredAt: events[i].OccurredAt,
+		})
+	}
+
+	_, err := o.DB.Collection(OutboxCollectionName).InsertMany(ctx, documents)
+	return toServiceError(err)
+
+}
+
+// Claim claims up to limit stored events that aren't claimed, oldest first, for lease.
+// The candidate events are claimed together with a new claim ID, and the events that were
+// claimed by a concurrent relay in between are left out.
+func (o MongoOutbox) Claim(ctx context.Context, limit int, lease time.Duration) ([]service.Event, error) {
+	collection := o.DB.Collection(OutboxCollectionName)
+	now := time.Now()
+	unclaimed := bson.D{bson.E{Key: OutboxBSONClaimedUntilField, Value: bson.D{bson.E{Key: "$lte", Value: now}}}}
+
+	findOptions := options.Find().
+		SetSort(bson.D{bson.E{Key: "_id", Value: 1}}).
+		SetLimit(int64(limit)).
+		SetProjection(bson.D{bson.E{Key: "_id", Value: 1}})
+
+	cursor, err := collection.Find(ctx, unclaimed, findOptions)
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	var candidates []OutboxBSON
+	if err := cursor.All(ctx, &candidates); err != nil {
+		return nil, toServiceError(err)
+	}
+
+	if len(candidates) == 0 {
+		return []service.Event{}, nil
+	}
+
+	ids := make(bson.A, 0, len(candidates))
+	for _, candidate := range candidates {
+		ids = append(ids, candidate.ID)
+	}
+
+	claimID := primitive.NewObjectID()
+	filter := bson.D{
+		unclaimed[0],
+		bson.E{Key: "_id", Value: bson.D{bson.E{Key: "$in", Value: ids}}},
+	}
+	update := bson.D{
+		bson.E{
+			Key: "$set",
+			Value: bson.D{
+				bson.E{Key: OutboxBSONClaimedUntilField, Value: now.Add(lease)},
+				bson.E{Key: OutboxBSONClaimIDField, Value: claimID},
+			},
+		},
+	}
+
+	if _, err := collection.UpdateMany(ctx, filter, update); err != nil {
+		return nil, toServiceError(err)
+	}
+
+	claimed := bson.D{
+		bson.E{Key: "_id", Value: bson.D{bson.E{Key: "$in", Value: ids}}},
+		bson.E{Key: OutboxBSONClaimIDField, Value: claimID},
+	}
+
+	cursor, err = collection.Find(ctx, claimed, options.Find().SetSort(bson.D{bson.E{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	var documents []OutboxBSON
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, toServiceError(err)
+	}
+
+	events := make([]service.Event, 0, len(documents))
+	for _, document := range documents {
+		events = append(events, document.toEvent())
+	}
+
+	return events, nil
+
+}
+
+// Delete deletes the events of ids after they were published.
+func (o MongoOutbox) Delete(ctx context.Context, ids []string) error {
+	objectIDs := make(bson.A, 0, len(ids))
+	for _, id := range ids {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			continue
+		}
+
+		objectIDs = append(objectIDs, objectID)
+	}
+
+	filter := bson.D{bson.E{Key: "_id", Value: bson.D{bson.E{Key: "$in", Value: objectIDs}}}}
+	_, err := o.DB.Collection(OutboxCollectionName).DeleteMany(ctx, filter)
+	return toServiceError(err)
+
+}
+
+// supportsTransactions returns whether the mongodb deployment of db supports multi-document transactions,
+// which replica sets and sharded clusters support but standalone servers don't.
+func supportsTransactions(ctx context.Context, db *mongo.Database) (bool, error) {
+	var isMaster struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+
+	if err := db.RunCommand(ctx, bson.D{bson.E{Key: "isMaster", Value: 1}}).Decode(&isMaster); err != nil {
+		return false, toServiceError(err)
+	}
+
+	return isMaster.SetName != "" || isMaster.Msg == "isdbgrid", nil
+
+}
@@ -0,0 +1,156 @@
+package mongodb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// pageToken is the position of the last favorite of a page in the sort order of the listing.
+type pageToken struct {
+	Sort      service.SortOrder `json:"s"`
+	ID        string            `json:"i"`
+	CreatedAt int64             `json:"c,omitempty"`
+	FileID    string            `json:"f,omitempty"`
+	Rank      string            `json:"r,omitempty"`
+	Pinned    bool              `json:"p,omitempty"`
+	DeletedAt int64             `json:"d,omitempty"`
+}
+
+// sortField is a field that favorites are sorted by and its direction,
+// 1 for ascending and -1 for descending.
+type sortField struct {
+	name      string
+	direction int
+}
+
+// sortFields returns the fields that favorites are sorted by in sort. Favorites with
+// the same values are sorted by _id in the direction of the last field.
+func sortFields(sort service.SortOrder) ([]sortField, error) {
+	switch sort {
+	case service.SortOldestFirst:
+		return []sortField{{name: FavoriteBSONCreatedAtField, direction: 1}}, nil
+	case service.SortNewestFirst:
+		return []sortField{{name: FavoriteBSONCreatedAtField, direction: -1}}, nil
+	case service.SortByFileID:
+		return []sortField{{name: FavoriteBSONFileIDField, direction: 1}}, nil
+	case service.SortManual:
+		return []sortField{
+			{name: FavoriteBSONPinnedField, direction: -1},
+			{name: FavoriteBSONRankField, direction: 1},
+		}, nil
+	case service.SortRecentlyDeleted:
+		return []sortField{{name: FavoriteBSONDeletedAtField, direction: -1}}, nil
+	default:
+		return nil, fmt.Errorf("unknown sort order %d", sort)
+	}
+
+}
+
+// sortKeys returns the fields that favorites are sorted by in sort, followed by _id.
+func sortKeys(sort service.SortOrder) ([]sortField, error) {
+	fields, err := sortFields(sort)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(fields, sortField{name: MongoObjectIDField, direction: fields[len(fields)-1].direction}), nil
+
+}
+
+// sortDocument returns the mongodb sort document of sort.
+func sortDocument(sort service.SortOrder) (bson.D, error) {
+	keys, err := sortKeys(sort)
+	if err != nil {
+		return nil, err
+	}
+
+	document := make(bson.D, 0, len(keys))
+	for _, key := range keys {
+		document = append(document, bson.E{Key: key.name, Value: key.direction})
+	}
+
+	return document, nil
+
+}
+
+// encodePageToken encodes the position of last, the last favorite of a page listed in sort order.
+func encodePageToken(sort service.SortOrder, last *BSON) string {
+	token := pageToken{Sort: sort, ID: last.ID.Hex()}
+
+	switch sort {
+	case service.SortOldestFirst, service.SortNewestFirst:
+		token.CreatedAt = service.UnixMillis(last.CreatedAt)
+	case service.SortByFileID:
+		token.FileID = last.FileID
+	case service.SortManual:
+		token.Rank = last.Rank
+		token.Pinned = last.Pinned
+	case service.SortRecentlyDeleted:
+		token.DeletedAt = service.UnixMillis(last.DeletedAt)
+	}
+
+	encoded, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+
+}
+
+// afterPageFilter decodes encodedToken and returns a filter matching the favorites
+// that come after it in sort order.
+// Returns service.ErrInvalidPageToken if encodedToken is malformed or wasn't returned for sort.
+func afterPageFilter(sort service.SortOrder, encodedToken string) (bson.D, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encodedToken)
+	if err != nil {
+		return nil, service.ErrInvalidPageToken
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(decoded, &token); err != nil || token.Sort != sort {
+		return nil, service.ErrInvalidPageToken
+	}
+
+	lastID, err := primitive.ObjectIDFromHex(token.ID)
+	if err != nil {
+		return nil, service.ErrInvalidPageToken
+	}
+
+	keys, err := sortKeys(sort)
+	if err != nil {
+		return nil, err
+	}
+
+	lastValues := map[string]interface{}{
+		FavoriteBSONCreatedAtField: time.Unix(0, token.CreatedAt*int64(time.Millisecond)).UTC(),
+		FavoriteBSONFileIDField:    token.FileID,
+		FavoriteBSONRankField:      token.Rank,
+		FavoriteBSONPinnedField:    token.Pinned,
+		FavoriteBSONDeletedAtField: time.Unix(0, token.DeletedAt*int64(time.Millisecond)).UTC(),
+		MongoObjectIDField:         lastID,
+	}
+
+	// A favorite comes after the last one if it has the same values for some of the first keys
+	// and a value that comes after the last one's for the next key.
+	after := make(bson.A, 0, len(keys))
+	for i, key := range keys {
+		operator := "$gt"
+		if key.direction < 0 {
+			operator = "$lt"
+		}
+
+		clause := make(bson.D, 0, i+1)
+		for _, equalKey := range keys[:i] {
+			clause = append(clause, bson.E{Key: equalKey.name, Value: lastValues[equalKey.name]})
+		}
+
+		clause = append(clause, bson.E{Key: key.name, Value: bson.D{bson.E{Key: operator, Value: lastValues[key.name]}}})
+		after = append(after, clause)
+	}
+
+	return bson.D{bson.E{Key: "$or", Value: after}}, nil
+
+}
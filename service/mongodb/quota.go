@@ -0,0 +1,131 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/meateam/fav-service/service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// QuotaCollectionName is the name of the favorite quotas collection.
+	QuotaCollectionName = "favoriteQuota"
+
+	// QuotaBSONUserIDField is the name of the userID field in QuotaBSON.
+	QuotaBSONUserIDField = "userID"
+
+	// QuotaBSONLimitField is the name of the limit field in QuotaBSON.
+	QuotaBSONLimitField = "limit"
+
+	// QuotaBSONVersionField is the name of the version field in QuotaBSON.
+	QuotaBSONVersionField = "version"
+)
+
+// QuotaBSON is the structure that represents the favorite quota of a user as it's stored.
+type QuotaBSON struct {
+	ID      primitive.ObjectID `bson:"_id,omitempty"`
+	UserID  string             `bson:"userID"`
+	Limit   int64              `bson:"limit,omitempty"`
+	Version int64              `bson:"version"`
+}
+
+// MongoQuotaStore must implement service.QuotaStore.
+var _ service.QuotaStore = MongoQuotaStore{}
+
+// MongoQuotaStore holds the mongodb database and implements QuotaStore interface.
+type MongoQuotaStore struct {
+	DB *mongo.Database
+}
+
+// newMongoQuotaStore returns a new quota store, creating the unique index of the quota of each user.
+func newMongoQuotaStore(db *mongo.Database) (MongoQuotaStore, error) {
+	collection := db.Collection(QuotaCollectionName)
+	_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			bson.E{
+				Key:   QuotaBSONUserIDField,
+				Value: 1,
+			},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return MongoQuotaStore{}, err
+	}
+
+	return MongoQuotaStore{DB: db}, nil
+}
+
+// Get returns the quota of userID, or a quota with no limit and a version of 0 if none is stored.
+func (s MongoQuotaStore) Get(ctx context.Context, userID string) (service.Quota, error) {
+	filter := bson.D{bson.E{Key: QuotaBSONUserIDField, Value: userID}}
+
+	var document QuotaBSON
+	err := s.DB.Collection(QuotaCollectionName).FindOne(ctx, filter).Decode(&document)
+	if err == mongo.ErrNoDocuments {
+		return service.Quota{UserID: userID}, nil
+	}
+
+	if err != nil {
+		return service.Quota{}, toServiceError(err)
+	}
+
+	return service.Quota{UserID: document.UserID, Limit: document.Limit, Version: document.Version}, nil
+
+}
+
+// IncrementVersion increments the version of the quota of userID if it's still version,
+// and returns false if it has changed since. The quota is created if it isn't stored, a stored quota
+// whose version has changed doesn't match the filter so the upsert fails on the unique index.
+func (s MongoQuotaStore) IncrementVersion(ctx context.Context, userID string, version int64) (bool, error) {
+	filter := bson.D{
+		bson.E{Key: QuotaBSONUserIDField, Value: userID},
+		bson.E{Key: QuotaBSONVersionField, Value: version},
+	}
+
+	update := bson.D{bson.E{Key: "$inc", Value: bson.D{bson.E{Key: QuotaBSONVersionField, Value: int64(1)}}}}
+	_, err := s.DB.Collection(QuotaCollectionName).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, toServiceError(err)
+	}
+
+	return true, nil
+
+}
+
+// SetLimit sets the limit override of userID, a limit of 0 removes the override.
+func (s MongoQuotaStore) SetLimit(ctx context.Context, userID string, limit int64) error {
+	filter := bson.D{bson.E{Key: QuotaBSONUserIDField, Value: userID}}
+
+	var update bson.D
+	if limit != 0 {
+		update = bson.D{
+			bson.E{Key: "$set", Value: bson.D{bson.E{Key: QuotaBSONLimitField, Value: limit}}},
+			bson.E{Key: "$setOnInsert", Value: bson.D{bson.E{Key: QuotaBSONVersionField, Value: int64(0)}}},
+		}
+	} else {
+		update = bson.D{
+			bson.E{Key: "$unset", Value: bson.D{bson.E{Key: QuotaBSONLimitField, Value: ""}}},
+			bson.E{Key: "$setOnInsert", Value: bson.D{bson.E{Key: QuotaBSONVersionField, Value: int64(0)}}},
+		}
+	}
+
+	_, err := s.DB.Collection(QuotaCollectionName).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return toServiceError(err)
+
+}
+
+// Delete deletes the quota of userID along with its limit override.
+func (s MongoQuotaStore) Delete(ctx context.Context, userID string) error {
+	filter := bson.D{bson.E{Key: QuotaBSONUserIDField, Value: userID}}
+	_, err := s.DB.Collection(QuotaCollectionName).DeleteOne(ctx, filter)
+	return toServiceError(err)
+
+}
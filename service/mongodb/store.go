@@ -2,7 +2,9 @@ package mongodb
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/meateam/fav-service/service"
 	"go.mongodb.org/mongo-driver/bson"
@@ -17,15 +19,58 @@ const (
 	MongoObjectIDField = "_id"
 
 	// FavoriteCollectionName is the name of the favorites collection.
-	FavoriteCollectionName = "favorite"	
+	FavoriteCollectionName = "favorite"
 
 	// FavoriteBSONFileIDField is the name of the fileID field in BSON.
 	FavoriteBSONFileIDField = "fileID"
 
+	// FavoriteBSONItemTypeField is the name of the itemType field in BSON.
+	FavoriteBSONItemTypeField = "itemType"
+
 	// FavoriteBSONUserIDField is the name of the userID field in BSON.
 	FavoriteBSONUserIDField = "userID"
+
+	// FavoriteBSONCreatedAtField is the name of the createdAt field in BSON.
+	FavoriteBSONCreatedAtField = "createdAt"
+
+	// FavoriteBSONUpdatedAtField is the name of the updatedAt field in BSON.
+	FavoriteBSONUpdatedAtField = "updatedAt"
+
+	// FavoriteBSONCollectionIDsField is the name of the collectionIDs field in BSON.
+	FavoriteBSONCollectionIDsField = "collectionIDs"
+
+	// FavoriteBSONTagsField is the name of the tags field in BSON.
+	FavoriteBSONTagsField = "tags"
+
+	// FavoriteBSONNoteField is the name of the note field in BSON.
+	FavoriteBSONNoteField = "note"
+
+	// FavoriteBSONRankField is the name of the rank field in BSON.
+	FavoriteBSONRankField = "rank"
+
+	// FavoriteBSONPinnedField is the name of the pinned field in BSON.
+	FavoriteBSONPinnedField = "pinned"
+
+	// FavoriteBSONExpiresAtField is the name of the expiresAt field in BSON.
+	FavoriteBSONExpiresAtField = "expiresAt"
+
+	// FavoriteBSONDeletedAtField is the name of the deletedAt field in BSON.
+	FavoriteBSONDeletedAtField = "deletedAt"
+
+	// FavoriteBSONHiddenField is the name of the hidden field in BSON.
+	FavoriteBSONHiddenField = "hidden"
+
+	// FavoriteBSONOrphanedField is the name of the orphaned field in BSON.
+	FavoriteBSONOrphanedField = "orphaned"
+
+	// FavoriteBSONDeletingField is the name of the field that a favorite is marked with right before it's
+	// permanently deleted, holding its userID, itemType and fileID.
+	FavoriteBSONDeletingField = "deleting"
 )
 
+// MongoStore must implement service.Store.
+var _ service.Store = MongoStore{}
+
 // MongoStore holds the mongodb database and implements Store interface.
 type MongoStore struct {
 	DB *mongo.Database
@@ -34,22 +79,222 @@ type MongoStore struct {
 // newMongoStore returns a new store.
 func newMongoStore(db *mongo.Database) (MongoStore, error) {
 	collection := db.Collection(FavoriteCollectionName)
-	indexes := collection.Indexes()
-	indexModel := mongo.IndexModel{
-		Keys: bson.D{
-			bson.E{
-				Key:   FavoriteBSONFileIDField,
-				Value: 1,
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				bson.E{
+					Key:   FavoriteBSONFileIDField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONUserIDField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONItemTypeField,
+					Value: 1,
+				},
 			},
-			bson.E{
-				Key:   FavoriteBSONUserIDField,
-				Value: 1,
+			Options: options.Index().SetUnique(true),
+		},
+		// Supports listing the favorites of a user of an item type in pages ordered by createdAt.
+		{
+			Keys: bson.D{
+				bson.E{
+					Key:   FavoriteBSONUserIDField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONItemTypeField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONCreatedAtField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   MongoObjectIDField,
+					Value: 1,
+				},
+			},
+		},
+		// Supports listing the favorites of a user in pages ordered by createdAt, in both directions.
+		{
+			Keys: bson.D{
+				bson.E{
+					Key:   FavoriteBSONUserIDField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONCreatedAtField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   MongoObjectIDField,
+					Value: 1,
+				},
+			},
+		},
+		// Supports listing the favorites of a file in pages ordered by createdAt.
+		{
+			Keys: bson.D{
+				bson.E{
+					Key:   FavoriteBSONFileIDField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONCreatedAtField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   MongoObjectIDField,
+					Value: 1,
+				},
 			},
 		},
-		Options: options.Index().SetUnique(true),
+		// Supports listing the favorites of a user in pages ordered by fileID.
+		{
+			Keys: bson.D{
+				bson.E{
+					Key:   FavoriteBSONUserIDField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONFileIDField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   MongoObjectIDField,
+					Value: 1,
+				},
+			},
+		},
+		// Supports listing the favorites of a user in a collection in pages ordered by createdAt.
+		{
+			Keys: bson.D{
+				bson.E{
+					Key:   FavoriteBSONUserIDField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONCollectionIDsField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONCreatedAtField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   MongoObjectIDField,
+					Value: 1,
+				},
+			},
+		},
+		// Supports listing the favorites of a user with a tag in pages ordered by createdAt.
+		{
+			Keys: bson.D{
+				bson.E{
+					Key:   FavoriteBSONUserIDField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONTagsField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONCreatedAtField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   MongoObjectIDField,
+					Value: 1,
+				},
+			},
+		},
+		// Supports listing the favorites of a user in pages in the order set by the user.
+		{
+			Keys: bson.D{
+				bson.E{
+					Key:   FavoriteBSONUserIDField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONPinnedField,
+					Value: -1,
+				},
+				bson.E{
+					Key:   FavoriteBSONRankField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   MongoObjectIDField,
+					Value: 1,
+				},
+			},
+		},
+		// Supports reconciling the favorites flagged as orphaned in pages ordered by fileID.
+		{
+			Keys: bson.D{
+				bson.E{
+					Key:   FavoriteBSONOrphanedField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONFileIDField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   MongoObjectIDField,
+					Value: 1,
+				},
+			},
+			Options: options.Index().SetSparse(true),
+		},
+		// Supports deleting the favorites that have expired.
+		{
+			Keys: bson.D{
+				bson.E{
+					Key:   FavoriteBSONExpiresAtField,
+					Value: 1,
+				},
+			},
+			Options: options.Index().SetSparse(true),
+		},
+		// Supports listing the recently deleted favorites of a user in pages ordered by deletedAt.
+		{
+			Keys: bson.D{
+				bson.E{
+					Key:   FavoriteBSONUserIDField,
+					Value: 1,
+				},
+				bson.E{
+					Key:   FavoriteBSONDeletedAtField,
+					Value: -1,
+				},
+				bson.E{
+					Key:   MongoObjectIDField,
+					Value: -1,
+				},
+			},
+			Options: options.Index().SetSparse(true),
+		},
+		// Supports purging the favorites deleted before the restore window.
+		{
+			Keys: bson.D{
+				bson.E{
+					Key:   FavoriteBSONDeletedAtField,
+					Value: 1,
+				},
+			},
+			Options: options.Index().SetSparse(true),
+		},
+	}
+
+	if err := migrate(context.Background(), db, FavoriteCollectionName, favoriteMigrations); err != nil {
+		return MongoStore{}, err
 	}
 
-	_, err := indexes.CreateOne(context.Background(), indexModel)
+	_, err := collection.Indexes().CreateMany(context.Background(), indexModels)
 	if err != nil {
 		return MongoStore{}, err
 	}
@@ -57,99 +302,488 @@ func newMongoStore(db *mongo.Database) (MongoStore, error) {
 	return MongoStore{DB: db}, nil
 }
 
+// GetAll gets all favorites that match filter, ordered by opts.Sort.
+// If there are no matching favorites at all, it will return empty array.
+// If opts.PageSize is set, the favorites are paged using the sort key and _id of the last
+// favorite of the page as the next page token, so pages stay stable under concurrent writes.
+// If successful returns an array of favorite objects and the next page token.
+func (s MongoStore) GetAll(ctx context.Context, filter interface{}, opts service.ListOptions) ([]service.Favorite, string, error) {
 
+	collection := s.DB.Collection(FavoriteCollectionName)
 
-// GetAll gets all user favorite files by userID. 
-// If the user doesnt have favorite files at all, it will return empty array. 
-// If successful returns an array of favorite objects. 
-func (s MongoStore) GetAll(ctx context.Context, filter interface{}) ([]primitive.D, error) {
+	sort, err := sortDocument(opts.Sort)
+	if err != nil {
+		return nil, "", toServiceError(err)
+	}
 
-	collection := s.DB.Collection(FavoriteCollectionName)
+	if opts.PageToken != "" {
+		afterPage, err := afterPageFilter(opts.Sort, opts.PageToken)
+		if err != nil {
+			return nil, "", toServiceError(err)
+		}
+
+		filter = bson.D{bson.E{Key: "$and", Value: bson.A{filter, afterPage}}}
+	}
+
+	findOptions := options.Find().SetSort(sort)
+	if opts.PageSize > 0 {
+		// Fetch a single extra favorite to know whether there's a next page.
+		findOptions.SetLimit(int64(opts.PageSize) + 1)
+	}
 
-	filterCursor, err := collection.Find(ctx, filter)
+	filterCursor, err := collection.Find(ctx, filter, findOptions)
 	if err != nil {
-		return nil, err
+		return nil, "", toServiceError(err)
 	}
 
-	var favFiles []bson.D
+	var favFiles []*BSON
 	if err = filterCursor.All(ctx, &favFiles); err != nil {
-		return nil, err
+		return nil, "", toServiceError(err)
+	}
+
+	nextPageToken := ""
+	if opts.PageSize > 0 && len(favFiles) > opts.PageSize {
+		favFiles = favFiles[:opts.PageSize]
+		nextPageToken = encodePageToken(opts.Sort, favFiles[len(favFiles)-1])
+	}
+
+	favorites := make([]service.Favorite, 0, len(favFiles))
+	for _, favFile := range favFiles {
+		favorites = append(favorites, favFile)
+	}
+
+	return favorites, nextPageToken, nil
+}
+
+// ForEach calls fn for each favorite that matches filter in sort order,
+// decoding the favorites one at a time off the cursor.
+func (s MongoStore) ForEach(ctx context.Context, filter interface{}, sort service.SortOrder, fn func(service.Favorite) error) error {
+	collection := s.DB.Collection(FavoriteCollectionName)
+
+	sortDoc, err := sortDocument(sort)
+	if err != nil {
+		return toServiceError(err)
 	}
 
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(sortDoc))
+	if err != nil {
+		return toServiceError(err)
+	}
+	defer cursor.Close(context.Background())
+
+	for cursor.Next(ctx) {
+		favorite := &BSON{}
+		if err := cursor.Decode(favorite); err != nil {
+			return toServiceError(err)
+		}
 
-	return favFiles, nil
+		if err := fn(favorite); err != nil {
+			return err
+		}
+	}
+
+	return toServiceError(cursor.Err())
 }
 
+// Count returns the number of favorites that match filter.
+func (s MongoStore) Count(ctx context.Context, filter interface{}) (int64, error) {
+	collection := s.DB.Collection(FavoriteCollectionName)
 
+	count, err := collection.CountDocuments(ctx, filter)
+	return count, toServiceError(err)
+}
 
-// Create creates a favorite object of userID and fileID.
-// If favorite already exists then it will return nil and error.
-// If successful returns the favorite obejct and a nil error. 
-func (s MongoStore) Create(ctx context.Context, favorite service.Favorite,) (service.Favorite, error) {
+// Create creates a favorite object of userID, item type and item ID.
+// If favorite already exists then it will return nil and service.ErrAlreadyExists.
+// If successful returns the favorite obejct and a nil error.
+func (s MongoStore) Create(ctx context.Context, favorite service.Favorite) (service.Favorite, error) {
 	collection := s.DB.Collection(FavoriteCollectionName)
 
-	fileID := favorite.GetFileID()
+	itemID := favorite.GetItemID()
 	userID := favorite.GetUserID()
 
-
-	if fileID == "" {
-		return nil, fmt.Errorf("fileID is required")
+	if itemID == "" {
+		return nil, fmt.Errorf("itemID is required")
 	}
 
 	if userID == "" {
 		return nil, fmt.Errorf("userID is required")
 	}
 
-	favObject := bson.D{
-		{Key: "userID", Value: userID},
-		{Key: "fileID", Value: fileID},
-	}
+	now := mongoTime(time.Now())
+	favObject := newBSON(favorite, now)
 
 	_, err := collection.InsertOne(ctx, favObject)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, service.ErrAlreadyExists
+	}
+
 	if err != nil {
-		return nil, err
+		return nil, toServiceError(err)
 	}
 
-	result := collection.FindOne(ctx, favObject)
+	result := collection.FindOne(ctx, bson.D{bson.E{Key: MongoObjectIDField, Value: favObject.ID}})
 	favoriteRes := &BSON{}
 	err = result.Decode(favoriteRes)
 	if err != nil {
-		return nil, err
+		return nil, toServiceError(err)
 	}
 
 	return favoriteRes, nil
 }
 
+// CreateMany creates favorites in a single unordered insert, so a favorite that fails
+// doesn't prevent the others from being created.
+// Returns the error of each favorite, service.ErrAlreadyExists if it already existed.
+func (s MongoStore) CreateMany(ctx context.Context, favorites []service.Favorite) ([]error, error) {
+	collection := s.DB.Collection(FavoriteCollectionName)
 
-// Delete deletes a favorite by userID and fileID. 
-// If favorite does not exists it will return nil and error. 
-// If successful returns the deleted favorite object. 
-func (s MongoStore) Delete(ctx context.Context, filter interface{}) (service.Favorite, error){
+	errs := make([]error, len(favorites))
+
+	// documentIndexes holds the index in favorites of each inserted document.
+	documents := make([]interface{}, 0, len(favorites))
+	documentIndexes := make([]int, 0, len(favorites))
+
+	now := mongoTime(time.Now())
+	for i, favorite := range favorites {
+		if favorite.GetItemID() == "" {
+			errs[i] = fmt.Errorf("itemID is required")
+			continue
+		}
+
+		if favorite.GetUserID() == "" {
+			errs[i] = fmt.Errorf("userID is required")
+			continue
+		}
+
+		documents = append(documents, newBSON(favorite, now))
+		documentIndexes = append(documentIndexes, i)
+	}
+
+	if len(documents) == 0 {
+		return errs, nil
+	}
+
+	_, err := collection.InsertMany(ctx, documents, options.InsertMany().SetOrdered(false))
+
+	var bulkErr mongo.BulkWriteException
+	if errors.As(err, &bulkErr) && bulkErr.WriteConcernError == nil {
+		for _, writeErr := range bulkErr.WriteErrors {
+			i := documentIndexes[writeErr.Index]
+			if isDuplicateKeyCode(writeErr.Code) {
+				errs[i] = service.ErrAlreadyExists
+			} else {
+				errs[i] = writeErr.WriteError
+			}
+		}
+
+		return errs, nil
+	}
+
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	return errs, nil
+}
+
+// Delete deletes a favorite by userID and fileID.
+// If favorite does not exists it will return nil and service.ErrNotFound.
+// If successful returns the deleted favorite object.
+func (s MongoStore) Delete(ctx context.Context, filter interface{}) (service.Favorite, error) {
 	collection := s.DB.Collection(FavoriteCollectionName)
 
 	result := collection.FindOneAndDelete(ctx, filter)
 
 	deletedFav := &BSON{}
 	err := result.Decode(deletedFav)
+	if err == mongo.ErrNoDocuments {
+		return nil, service.ErrNotFound
+	}
 
 	if err != nil {
-		return nil, err
+		return nil, toServiceError(err)
 	}
 
 	return deletedFav, nil
 
 }
 
+// DeleteMany deletes all favorites that match filter.
+// The favorites that weren't deleted or hidden are marked with their user and item right before they're deleted,
+// so change streams, whose deletion events hold only the _id of the deleted favorite, watch their deletion.
+// If successful returns the number of deleted favorites.
+func (s MongoStore) DeleteMany(ctx context.Context, filter interface{}) (int64, error) {
+	collection := s.DB.Collection(FavoriteCollectionName)
+
+	visibleFilter := bson.D{
+		bson.E{
+			Key: "$and",
+			Value: bson.A{
+				filter,
+				bson.D{
+					bson.E{Key: FavoriteBSONDeletedAtField, Value: bson.D{bson.E{Key: "$exists", Value: false}}},
+					bson.E{Key: FavoriteBSONHiddenField, Value: bson.D{bson.E{Key: "$ne", Value: true}}},
+				},
+			},
+		},
+	}
+
+	deletingUpdate := mongo.Pipeline{
+		bson.D{
+			bson.E{
+				Key: "$set",
+				Value: bson.D{
+					bson.E{
+						Key: FavoriteBSONDeletingField,
+						Value: bson.D{
+							bson.E{Key: FavoriteBSONUserIDField, Value: "$" + FavoriteBSONUserIDField},
+							bson.E{Key: FavoriteBSONItemTypeField, Value: "$" + FavoriteBSONItemTypeField},
+							bson.E{Key: FavoriteBSONFileIDField, Value: "$" + FavoriteBSONFileIDField},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if _, err := collection.UpdateMany(ctx, visibleFilter, deletingUpdate); err != nil {
+		return 0, toServiceError(err)
+	}
+
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, toServiceError(err)
+	}
+
+	return result.DeletedCount, nil
+}
+
+// Update applies update to a favorite that matches filter.
+// If favorite does not exists it will return nil and service.ErrNotFound.
+// If successful returns the updated favorite object.
+func (s MongoStore) Update(ctx context.Context, filter interface{}, update service.FavoriteUpdate) (service.Favorite, error) {
+	collection := s.DB.Collection(FavoriteCollectionName)
+
+	updateDocument := newUpdateDocument(update)
+	result := collection.FindOneAndUpdate(ctx, filter, updateDocument, options.FindOneAndUpdate().SetReturnDocument(options.After))
+
+	updatedFav := &BSON{}
+	err := result.Decode(updatedFav)
+	if err == mongo.ErrNoDocuments {
+		return nil, service.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	return updatedFav, nil
+}
+
+// UpdateMany applies update to all favorites that match filter.
+// If successful returns the number of matching favorites.
+func (s MongoStore) UpdateMany(ctx context.Context, filter interface{}, update service.FavoriteUpdate) (int64, error) {
+	collection := s.DB.Collection(FavoriteCollectionName)
+
+	result, err := collection.UpdateMany(ctx, filter, newUpdateDocument(update))
+	if err != nil {
+		return 0, toServiceError(err)
+	}
+
+	return result.MatchedCount, nil
+}
+
+// newUpdateDocument returns the update document of update, which also sets the update time.
+func newUpdateDocument(update service.FavoriteUpdate) bson.D {
+	set := bson.D{bson.E{Key: FavoriteBSONUpdatedAtField, Value: mongoTime(time.Now())}}
+	unset := bson.D{}
+	if update.SetTags && len(update.Tags) > 0 {
+		set = append(set, bson.E{Key: FavoriteBSONTagsField, Value: update.Tags})
+	} else if update.SetTags {
+		unset = append(unset, bson.E{Key: FavoriteBSONTagsField, Value: ""})
+	}
+
+	if update.SetNote && update.Note != "" {
+		set = append(set, bson.E{Key: FavoriteBSONNoteField, Value: update.Note})
+	} else if update.SetNote {
+		unset = append(unset, bson.E{Key: FavoriteBSONNoteField, Value: ""})
+	}
+
+	if update.SetRank {
+		set = append(set, bson.E{Key: FavoriteBSONRankField, Value: update.Rank})
+	}
+
+	if update.SetPinned {
+		set = append(set, bson.E{Key: FavoriteBSONPinnedField, Value: update.Pinned})
+	}
+
+	if update.SetDeletedAt && !update.DeletedAt.IsZero() {
+		set = append(set, bson.E{Key: FavoriteBSONDeletedAtField, Value: mongoTime(update.DeletedAt)})
+	} else if update.SetDeletedAt {
+		unset = append(unset, bson.E{Key: FavoriteBSONDeletedAtField, Value: ""})
+	}
+
+	updateDocument := bson.D{bson.E{Key: "$set", Value: set}}
+	if len(unset) > 0 {
+		updateDocument = append(updateDocument, bson.E{Key: "$unset", Value: unset})
+	}
+
+	return updateDocument
+}
+
+// AddToCollections adds collectionIDs to the collections of all favorites that match filter.
+// If successful returns the number of matching favorites.
+func (s MongoStore) AddToCollections(ctx context.Context, filter interface{}, collectionIDs []string) (int64, error) {
+	return s.updateCollections(ctx, filter, bson.E{
+		Key: "$addToSet",
+		Value: bson.D{
+			bson.E{
+				Key:   FavoriteBSONCollectionIDsField,
+				Value: bson.D{bson.E{Key: "$each", Value: collectionIDs}},
+			},
+		},
+	})
+}
+
+// RemoveFromCollections removes collectionIDs from the collections of all favorites that match filter.
+// If successful returns the number of matching favorites.
+func (s MongoStore) RemoveFromCollections(ctx context.Context, filter interface{}, collectionIDs []string) (int64, error) {
+	return s.updateCollections(ctx, filter, bson.E{
+		Key: "$pullAll",
+		Value: bson.D{
+			bson.E{
+				Key:   FavoriteBSONCollectionIDsField,
+				Value: collectionIDs,
+			},
+		},
+	})
+}
+
+// updateCollections applies the collectionIDs update operator to all favorites that match filter
+// and sets their update time. Returns the number of matching favorites.
+func (s MongoStore) updateCollections(ctx context.Context, filter interface{}, update bson.E) (int64, error) {
+	collection := s.DB.Collection(FavoriteCollectionName)
+
+	now := mongoTime(time.Now())
+	result, err := collection.UpdateMany(ctx, filter, bson.D{
+		update,
+		bson.E{
+			Key:   "$set",
+			Value: bson.D{bson.E{Key: FavoriteBSONUpdatedAtField, Value: now}},
+		},
+	})
+	if err != nil {
+		return 0, toServiceError(err)
+	}
+
+	return result.MatchedCount, nil
+}
+
+// SetHidden sets whether all favorites that match filter are hidden and sets their update time.
+// The hidden field is removed from favorites that aren't hidden, and the orphaned field from all of them.
+// If successful returns the number of matching favorites.
+func (s MongoStore) SetHidden(ctx context.Context, filter interface{}, hidden bool) (int64, error) {
+	collection := s.DB.Collection(FavoriteCollectionName)
+
+	set := bson.D{bson.E{Key: FavoriteBSONUpdatedAtField, Value: mongoTime(time.Now())}}
+	unset := bson.D{bson.E{Key: FavoriteBSONOrphanedField, Value: ""}}
+	if hidden {
+		set = append(set, bson.E{Key: FavoriteBSONHiddenField, Value: true})
+	} else {
+		unset = append(unset, bson.E{Key: FavoriteBSONHiddenField, Value: ""})
+	}
+
+	result, err := collection.UpdateMany(ctx, filter, bson.D{bson.E{Key: "$unset", Value: unset}, bson.E{Key: "$set", Value: set}})
+	if err != nil {
+		return 0, toServiceError(err)
+	}
+
+	return result.MatchedCount, nil
+}
+
+// SetOrphaned sets whether all favorites that match filter are hidden as orphaned and sets their update time.
+// The hidden and orphaned fields are removed from favorites that aren't orphaned.
+// If successful returns the number of matching favorites.
+func (s MongoStore) SetOrphaned(ctx context.Context, filter interface{}, orphaned bool) (int64, error) {
+	collection := s.DB.Collection(FavoriteCollectionName)
+
+	set := bson.D{bson.E{Key: FavoriteBSONUpdatedAtField, Value: mongoTime(time.Now())}}
+	update := bson.D{}
+	if orphaned {
+		set = append(set, bson.E{Key: FavoriteBSONHiddenField, Value: true}, bson.E{Key: FavoriteBSONOrphanedField, Value: true})
+	} else {
+		update = append(update, bson.E{
+			Key:   "$unset",
+			Value: bson.D{bson.E{Key: FavoriteBSONHiddenField, Value: ""}, bson.E{Key: FavoriteBSONOrphanedField, Value: ""}},
+		})
+	}
+
+	result, err := collection.UpdateMany(ctx, filter, append(update, bson.E{Key: "$set", Value: set}))
+	if err != nil {
+		return 0, toServiceError(err)
+	}
+
+	return result.MatchedCount, nil
+}
+
 // HealthCheck checks the health of the service, returns true if healthy, or false otherwise.
 func (s MongoStore) HealthCheck(ctx context.Context) (bool, error) {
 	if err := s.DB.Client().Ping(ctx, readpref.Primary()); err != nil {
-		return false, err
+		return false, toServiceError(err)
 	}
 
 	return true, nil
 }
 
+// newBSON returns the document of favorite to insert, updated at now. The _id of the document
+// is generated up front, since the service.DefaultRank of a favorite without a rank is made of it.
+func newBSON(favorite service.Favorite, now time.Time) *BSON {
+	document := &BSON{
+		ID:        primitive.NewObjectID(),
+		FileID:    favorite.GetItemID(),
+		ItemType:  favorite.GetItemType(),
+		UserID:    favorite.GetUserID(),
+		CreatedAt: creationTime(favorite, now),
+		UpdatedAt: now,
+		Tags:      favorite.GetTags(),
+		Note:      favorite.GetNote(),
+		Rank:      favorite.GetRank(),
+		Pinned:    favorite.GetPinned(),
+	}
 
+	if document.Rank == "" {
+		document.Rank = service.DefaultRank(document.CreatedAt, document.ID.Hex())
+	}
 
+	if !favorite.GetExpiresAt().IsZero() {
+		document.ExpiresAt = mongoTime(favorite.GetExpiresAt())
+	}
+
+	if !favorite.GetDeletedAt().IsZero() {
+		document.DeletedAt = mongoTime(favorite.GetDeletedAt())
+	}
 
+	return document
+}
+
+// creationTime returns the creation time of favorite if it has one, such as a favorite
+// transferred from another user, or now otherwise.
+func creationTime(favorite service.Favorite, now time.Time) time.Time {
+	if favorite.GetCreatedAt().IsZero() {
+		return now
+	}
 
+	return mongoTime(favorite.GetCreatedAt())
+}
+
+// mongoTime returns t in UTC as it's stored.
+// Mongodb stores dates in millisecond precision.
+func mongoTime(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Millisecond)
+}
+
+// isDuplicateKeyCode returns true if code is the code of a duplicate key write error.
+func isDuplicateKeyCode(code int) bool {
+	return code == 11000 || code == 11001 || code == 12582
+}
@@ -0,0 +1,93 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+	"github.com/meateam/fav-service/service/storetest"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// envTestMongoHost is the environment variable of the mongodb connection string used by the tests.
+// The tests are skipped if it's not set.
+const envTestMongoHost = "FVS_TEST_MONGO_HOST"
+
+func TestMongoStore(t *testing.T) {
+	client := connectTestMongo(t)
+
+	storetest.Run(t, storetest.Harness{
+		NewStore: func(t *testing.T) service.Store {
+			store, err := newMongoStore(newTestDatabase(t, client))
+			if err != nil {
+				t.Fatalf("newMongoStore() error = %v", err)
+			}
+
+			return store
+		},
+		Filter: filterBuilder{},
+		NewCollectionStore: func(t *testing.T) service.CollectionStore {
+			store, err := newMongoCollectionStore(newTestDatabase(t, client))
+			if err != nil {
+				t.Fatalf("newMongoCollectionStore() error = %v", err)
+			}
+
+			return store
+		},
+		NewQuotaStore: func(t *testing.T) service.QuotaStore {
+			store, err := newMongoQuotaStore(newTestDatabase(t, client))
+			if err != nil {
+				t.Fatalf("newMongoQuotaStore() error = %v", err)
+			}
+
+			return store
+		},
+		NewOutbox: func(t *testing.T) service.Outbox {
+			outbox, err := newMongoOutbox(newTestDatabase(t, client))
+			if err != nil {
+				t.Fatalf("newMongoOutbox() error = %v", err)
+			}
+
+			return outbox
+		},
+	})
+
+}
+
+// connectTestMongo connects to the mongodb of envTestMongoHost, or skips the test if it's not set.
+func connectTestMongo(t *testing.T) *mongo.Client {
+	connectionString := os.Getenv(envTestMongoHost)
+	if connectionString == "" {
+		t.Skipf("%s is not set", envTestMongoHost)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(connectionString))
+	if err != nil {
+		t.Fatalf("failed connecting to mongodb with connection string %s: %v", connectionString, err)
+	}
+
+	t.Cleanup(func() {
+		client.Disconnect(context.Background())
+	})
+
+	return client
+
+}
+
+// newTestDatabase returns a new database of client that is dropped when the test ends.
+func newTestDatabase(t *testing.T, client *mongo.Client) *mongo.Database {
+	db := client.Database(fmt.Sprintf("favorite_test_%d", time.Now().UnixNano()))
+	t.Cleanup(func() {
+		db.Drop(context.Background())
+	})
+
+	return db
+
+}
@@ -0,0 +1,197 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/meateam/fav-service/service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// resumeTokenErrorCodes are the codes of the mongodb errors of resuming a change stream from
+// a resume token that is malformed or whose change is no longer in the oplog.
+var resumeTokenErrorCodes = map[int32]bool{
+	2:   true, // BadValue
+	9:   true, // FailedToParse
+	260: true, // InvalidResumeToken
+	280: true, // ChangeStreamFatalError
+	286: true, // ChangeStreamHistoryLost
+}
+
+// changeEventBSON is the structure that represents a change event of the favorites collection.
+type changeEventBSON struct {
+	OperationType string `bson:"operationType"`
+
+	// DocumentKey holds the _id of the changed favorite.
+	DocumentKey struct {
+		ID primitive.ObjectID `bson:"_id"`
+	} `bson:"documentKey"`
+
+	// FullDocument is the favorite after the change, nil for updates of favorites
+	// that were deleted before the change was read.
+	FullDocument      *BSON `bson:"fullDocument"`
+	UpdateDescription struct {
+		UpdatedFields bson.M   `bson:"updatedFields"`
+		RemovedFields []string `bson:"removedFields"`
+	} `bson:"updateDescription"`
+}
+
+// changeStreamWatcher must implement service.Watcher.
+var _ service.Watcher = changeStreamWatcher{}
+
+// changeStreamWatcher watches the changes of favorites with change streams of the favorites collection of db,
+// which replica sets and sharded clusters support.
+type changeStreamWatcher struct {
+	db *mongo.Database
+}
+
+// WatchFavorites calls fn for each change of the favorites of userID, starting after the change of resumeToken
+// if it's set, until ctx is done or fn returns an error.
+func (w changeStreamWatcher) WatchFavorites(ctx context.Context, userID string, resumeToken string, fn func(service.Change) error) error {
+	return w.watchChangeStream(ctx, userID, resumeToken, fn)
+
+}
+
+// watchChangeStream calls fn for each change of the favorites of userID read from a change stream of
+// the favorites collection, which is filtered by the user of the changed favorites on the server.
+// Soft deleting and restoring a favorite are watched as deleting and creating it. Permanently deleting
+// a favorite is watched by the update that marks it as deleting right before it's deleted, since a deletion
+// event holds only the _id of the deleted document and the driver can't request its pre-image.
+func (w changeStreamWatcher) watchChangeStream(ctx context.Context, userID string, resumeToken string, fn func(service.Change) error) error {
+	deletingUserIDField := "updateDescription.updatedFields." + FavoriteBSONDeletingField + "." + FavoriteBSONUserIDField
+	pipeline := mongo.Pipeline{
+		bson.D{
+			bson.E{
+				Key: "$match",
+				Value: bson.D{
+					bson.E{
+						Key: "$or",
+						Value: bson.A{
+							bson.D{bson.E{Key: "fullDocument." + FavoriteBSONUserIDField, Value: userID}},
+							bson.D{bson.E{Key: deletingUserIDField, Value: userID}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	streamOptions := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if resumeToken != "" {
+		streamOptions.SetResumeAfter(bson.D{bson.E{Key: "_data", Value: resumeToken}})
+	}
+
+	stream, err := w.db.Collection(FavoriteCollectionName).Watch(ctx, pipeline, streamOptions)
+	if err != nil {
+		return toResumeError(err, resumeToken)
+	}
+	defer stream.Close(context.Background())
+
+	for stream.Next(ctx) {
+		var event changeEventBSON
+		if err := stream.Decode(&event); err != nil {
+			return toServiceError(err)
+		}
+
+		change, ok := toChange(event)
+		if !ok {
+			continue
+		}
+
+		change.ResumeToken = stream.ResumeToken().Lookup("_data").StringValue()
+		if err := fn(change); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if err := stream.Err(); err != nil {
+		return toResumeError(err, resumeToken)
+	}
+
+	return service.NewAbortedError("favorites change stream was invalidated, reload the favorites and watch again", nil)
+
+}
+
+// toChange returns the change of event, and whether it's a change that is watched.
+func toChange(event changeEventBSON) (service.Change, bool) {
+	switch event.OperationType {
+	case "insert", "replace":
+		if event.FullDocument == nil {
+			return service.Change{}, false
+		}
+
+		return service.Change{Type: service.ChangeCreated, Favorite: event.FullDocument}, true
+	case "update":
+		// The full document of a favorite marked as deleting is usually gone by the time the change is read,
+		// so the deleted favorite is keyed on the documentKey of the change and the fields of the mark.
+		if deleting, ok := event.UpdateDescription.UpdatedFields[FavoriteBSONDeletingField]; ok {
+			favorite, err := deletingFavorite(event.DocumentKey.ID, deleting)
+			return service.Change{Type: service.ChangeDeleted, Favorite: favorite}, err == nil
+		}
+
+		if event.FullDocument == nil {
+			return service.Change{}, false
+		}
+
+		if _, ok := event.UpdateDescription.UpdatedFields[FavoriteBSONDeletedAtField]; ok {
+			return service.Change{Type: service.ChangeDeleted, Favorite: event.FullDocument}, true
+		}
+
+		// Hiding and unhiding the favorites of a trashed file change only the favorites that weren't deleted.
+		if _, ok := event.UpdateDescription.UpdatedFields[FavoriteBSONHiddenField]; ok {
+			return service.Change{Type: service.ChangeDeleted, Favorite: event.FullDocument}, event.FullDocument.DeletedAt.IsZero()
+		}
+
+		for _, field := range event.UpdateDescription.RemovedFields {
+			if field == FavoriteBSONDeletedAtField {
+				return service.Change{Type: service.ChangeCreated, Favorite: event.FullDocument}, true
+			}
+
+			if field == FavoriteBSONHiddenField {
+				return service.Change{Type: service.ChangeCreated, Favorite: event.FullDocument}, event.FullDocument.DeletedAt.IsZero()
+			}
+		}
+
+		return service.Change{Type: service.ChangeUpdated, Favorite: event.FullDocument}, true
+	}
+
+	return service.Change{}, false
+
+}
+
+// deletingFavorite returns the favorite of id that was marked as deleting with deleting,
+// the value of the deleting field.
+func deletingFavorite(id primitive.ObjectID, deleting interface{}) (*BSON, error) {
+	raw, err := bson.Marshal(deleting)
+	if err != nil {
+		return nil, err
+	}
+
+	favorite := &BSON{}
+	if err := bson.Unmarshal(raw, favorite); err != nil {
+		return nil, err
+	}
+
+	favorite.ID = id
+	return favorite, nil
+
+}
+
+// toResumeError returns service.ErrInvalidResumeToken if err is the error of resuming a change stream
+// from resumeToken, otherwise returns err as a service error.
+func toResumeError(err error, resumeToken string) error {
+	var commandErr mongo.CommandError
+	if resumeToken != "" && errors.As(err, &commandErr) && resumeTokenErrorCodes[commandErr.Code] {
+		return service.ErrInvalidResumeToken
+	}
+
+	return toServiceError(err)
+
+}
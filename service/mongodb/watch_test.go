@@ -0,0 +1,48 @@
+package mongodb
+
+import (
+	"testing"
+
+	"github.com/meateam/fav-service/service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestToChangeDeleting(t *testing.T) {
+	id := primitive.NewObjectID()
+	deleting := bson.D{
+		bson.E{Key: FavoriteBSONUserIDField, Value: "user"},
+		bson.E{Key: FavoriteBSONItemTypeField, Value: service.ItemTypeFolder},
+		bson.E{Key: FavoriteBSONFileIDField, Value: "folder"},
+	}
+
+	raw, err := bson.Marshal(bson.D{
+		bson.E{Key: "operationType", Value: "update"},
+		bson.E{Key: "documentKey", Value: bson.D{bson.E{Key: MongoObjectIDField, Value: id}}},
+		bson.E{Key: "fullDocument", Value: nil},
+		bson.E{Key: "updateDescription", Value: bson.D{
+			bson.E{Key: "updatedFields", Value: bson.D{bson.E{Key: FavoriteBSONDeletingField, Value: deleting}}},
+			bson.E{Key: "removedFields", Value: bson.A{}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("bson.Marshal() error = %v", err)
+	}
+
+	var event changeEventBSON
+	if err := bson.Unmarshal(raw, &event); err != nil {
+		t.Fatalf("bson.Unmarshal() error = %v", err)
+	}
+
+	change, ok := toChange(event)
+	if !ok {
+		t.Fatalf("toChange() of a favorite marked as deleting isn't watched")
+	}
+
+	favorite, _ := change.Favorite.(*BSON)
+	if change.Type != service.ChangeDeleted || favorite == nil || favorite.ID != id ||
+		favorite.GetUserID() != "user" || favorite.GetItemType() != service.ItemTypeFolder || favorite.GetItemID() != "folder" {
+		t.Errorf("toChange() = %s %+v, want the deletion of the folder of user keyed on %s", change.Type, change.Favorite, id.Hex())
+	}
+
+}
@@ -0,0 +1,82 @@
+// Package publisher implements service.EventPublisher for running the service locally and in tests,
+// publishing the events of favorite mutations to a channel in the same process or to a file.
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/meateam/fav-service/service"
+)
+
+// ChannelPublisher must implement service.EventPublisher.
+var _ service.EventPublisher = ChannelPublisher{}
+
+// ChannelPublisher publishes events to a channel in the same process.
+type ChannelPublisher struct {
+	Events chan service.Event
+}
+
+// NewChannelPublisher returns a new ChannelPublisher whose channel buffers up to size events.
+func NewChannelPublisher(size int) ChannelPublisher {
+	return ChannelPublisher{Events: make(chan service.Event, size)}
+
+}
+
+// Publish sends event to the channel of p, waiting for the channel to have room for it
+// until ctx is done.
+func (p ChannelPublisher) Publish(ctx context.Context, event service.Event) error {
+	select {
+	case p.Events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+}
+
+// FilePublisher must implement service.EventPublisher.
+var _ service.EventPublisher = (*FilePublisher)(nil)
+
+// FilePublisher publishes events by appending them to a file, each as a line of JSON.
+type FilePublisher struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFilePublisher returns a new FilePublisher appending to the file of path, which is created if it doesn't exist.
+func NewFilePublisher(path string) (*FilePublisher, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FilePublisher{file: file}, nil
+
+}
+
+// Publish appends event to the file of p and syncs it to disk.
+func (p *FilePublisher) Publish(ctx context.Context, event service.Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+
+	return p.file.Sync()
+
+}
+
+// Close closes the file of p.
+func (p *FilePublisher) Close() error {
+	return p.file.Close()
+
+}
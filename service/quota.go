@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/meateam/fav-service/proto"
+)
+
+// MaxQuotaAttempts is the number of times creating favorites is attempted when favorites of
+// the same user are created concurrently, before failing with an aborted error.
+const MaxQuotaAttempts = 5
+
+// UnlimitedQuota is the limit of a user that may have any number of favorites.
+// As a limit override, it makes the user unlimited regardless of the default limit.
+const UnlimitedQuota int64 = -1
+
+// Quota is the stored favorite quota of a user.
+type Quota struct {
+	UserID string
+
+	// Limit is the maximum number of favorites of the user that overrides the default limit,
+	// 0 if the user has no override or UnlimitedQuota if the user is unlimited.
+	Limit int64
+
+	// Version is incremented by every create of favorites of the user, so creates that
+	// ran concurrently and might together exceed the limit are detected.
+	Version int64
+}
+
+// QuotaUsage is the number of favorites of a user along with the limit that applies to the user.
+type QuotaUsage struct {
+	UserID string
+	Usage  int64
+
+	// Limit is the maximum number of favorites of the user, UnlimitedQuota if the user is unlimited.
+	Limit int64
+}
+
+// MarshalProto marshals q into quota.
+func (q QuotaUsage) MarshalProto(quota *pb.FavoriteQuota) error {
+	quota.UserID = q.UserID
+	quota.Usage = q.Usage
+	quota.Limit = q.Limit
+
+	return nil
+
+}
+
+// QuotaStore is an interface for storing the favorite quotas of users.
+type QuotaStore interface {
+	// Get returns the quota of userID, or a quota with no limit and a version of 0 if none is stored.
+	Get(ctx context.Context, userID string) (Quota, error)
+
+	// IncrementVersion increments the version of the quota of userID if it's still version,
+	// and returns false if it has changed since.
+	IncrementVersion(ctx context.Context, userID string, version int64) (bool, error)
+
+	// SetLimit sets the limit override of userID, a limit of 0 removes the override.
+	SetLimit(ctx context.Context, userID string, limit int64) error
+
+	// Delete deletes the quota of userID along with its limit override, does nothing if none is stored.
+	Delete(ctx context.Context, userID string) error
+}
+
+// QuotaLimit returns the limit of quota, which is its override if it has one and defaultLimit otherwise.
+// Returns UnlimitedQuota if the user is unlimited, including when there's no override and defaultLimit
+// isn't positive.
+func QuotaLimit(quota Quota, defaultLimit int64) int64 {
+	if quota.Limit != 0 {
+		return quota.Limit
+	}
+
+	if defaultLimit <= 0 {
+		return UnlimitedQuota
+	}
+
+	return defaultLimit
+
+}
+
+// CreatedItemIDs returns the item IDs of the favorites that were created by their item type,
+// given errs, the error of creating each of favorites.
+func CreatedItemIDs(favorites []Favorite, errs []error) map[string][]string {
+	created := make(map[string][]string)
+	for i, favorite := range favorites {
+		if errs[i] == nil {
+			created[favorite.GetItemType()] = append(created[favorite.GetItemType()], favorite.GetItemID())
+		}
+	}
+
+	return created
+
+}
+
+// CountItemIDs returns the number of item IDs in itemIDs, item IDs by their item type.
+func CountItemIDs(itemIDs map[string][]string) int64 {
+	var count int64
+	for _, ids := range itemIDs {
+		count += int64(len(ids))
+	}
+
+	return count
+
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// rankDigits are the digits of ranks in ascending order, which is also their byte order.
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// MaxRankMillis is the largest time in milliseconds since the unix epoch that DefaultRank orders correctly.
+const MaxRankMillis = 9999999999999
+
+// Ranks order the favorites of a user in SortManual order, favorites are ordered by their
+// ranks as strings. A rank is a fraction in base len(rankDigits) whose digits follow
+// the "0." point, so there is always another rank between any two ranks, and moving
+// a favorite only changes its own rank. Ranks never end with the digit 0, since there is
+// no rank between "x" and "x0".
+
+// DefaultRank returns the rank of a new favorite created at createdAt, which orders new favorites
+// newest first. tiebreak is a unique string of the favorite, such as its id, that orders
+// favorites created at the same time.
+func DefaultRank(createdAt time.Time, tiebreak string) string {
+	return fmt.Sprintf("%013d%s1", MaxRankMillis-UnixMillis(createdAt), tiebreak)
+
+}
+
+// RankBetween returns a rank that comes after prev and before next. An empty prev comes before
+// all ranks and an empty next comes after all ranks, so RankBetween("", next) returns a rank
+// before next and RankBetween(prev, "") returns a rank after prev.
+func RankBetween(prev string, next string) (string, error) {
+	if !validRank(prev) {
+		return "", fmt.Errorf("invalid rank %q", prev)
+	}
+
+	if !validRank(next) {
+		return "", fmt.Errorf("invalid rank %q", next)
+	}
+
+	if next != "" && prev >= next {
+		return "", fmt.Errorf("rank %q must come before rank %q", prev, next)
+	}
+
+	return midpointRank(prev, next), nil
+
+}
+
+// midpointRank returns a rank between prev and next, prev must come before next.
+// An empty next comes after all ranks.
+func midpointRank(prev string, next string) string {
+	if next != "" {
+		// Keep the common prefix, the digits of prev past its end are 0.
+		n := 0
+		for n < len(next) && rankDigit(prev, n) == next[n] {
+			n++
+		}
+
+		if n > 0 {
+			rest := ""
+			if n < len(prev) {
+				rest = prev[n:]
+			}
+
+			return next[:n] + midpointRank(rest, next[n:])
+		}
+	}
+
+	prevDigit := 0
+	if prev != "" {
+		prevDigit = strings.IndexByte(rankDigits, prev[0])
+	}
+
+	nextDigit := len(rankDigits)
+	if next != "" {
+		nextDigit = strings.IndexByte(rankDigits, next[0])
+	}
+
+	if nextDigit-prevDigit > 1 {
+		return string(rankDigits[(prevDigit+nextDigit+1)/2])
+	}
+
+	// The first digits are consecutive, next[:1] is between them if next has more digits.
+	if len(next) > 1 {
+		return next[:1]
+	}
+
+	if prev == "" {
+		return string(rankDigits[prevDigit]) + midpointRank("", "")
+	}
+
+	return prev[:1] + midpointRank(prev[1:], "")
+
+}
+
+// rankDigit returns the digit of rank at i, or 0 if rank is shorter.
+func rankDigit(rank string, i int) byte {
+	if i < len(rank) {
+		return rank[i]
+	}
+
+	return rankDigits[0]
+
+}
+
+// validRank returns true if rank is empty or a valid rank.
+func validRank(rank string) bool {
+	if rank == "" {
+		return true
+	}
+
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(rankDigits, rank[i]) == -1 {
+			return false
+		}
+	}
+
+	return rank[len(rank)-1] != rankDigits[0]
+
+}
+
+// MoveRank returns the rank of favorite after moving it between previous and next in the SortManual
+// order of its user, either of them may be nil to move favorite to the top or the bottom.
+// Favorite stays in its pinned section, so a neighbor of the other section is ignored and favorite
+// is moved to the edge of its section. Returns an aborted error if previous doesn't come before next,
+// since the order was probably changed concurrently.
+func MoveRank(favorite Favorite, previous Favorite, next Favorite) (string, error) {
+	previousRank := ""
+	if previous != nil && previous.GetPinned() == favorite.GetPinned() {
+		previousRank = previous.GetRank()
+	}
+
+	nextRank := ""
+	if next != nil && next.GetPinned() == favorite.GetPinned() {
+		nextRank = next.GetRank()
+	}
+
+	if nextRank != "" && previousRank >= nextRank {
+		return "", NewAbortedError(
+			"previous favorite must come before next favorite, the order may have changed, try again",
+			map[string]string{"previousFileID": previous.GetFileID(), "nextFileID": next.GetFileID()},
+		)
+	}
+
+	return RankBetween(previousRank, nextRank)
+
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// assertBetween fails t unless rank is a valid rank after prev and before next.
+func assertBetween(t *testing.T, rank string, prev string, next string) {
+	t.Helper()
+
+	if !validRank(rank) || rank == "" {
+		t.Fatalf("rank %q is invalid", rank)
+	}
+
+	if rank <= prev || (next != "" && rank >= next) {
+		t.Fatalf("rank %q isn't between %q and %q", rank, prev, next)
+	}
+
+}
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		prev string
+		next string
+	}{
+		{name: "Empty", prev: "", next: ""},
+		{name: "Top", prev: "", next: "i"},
+		{name: "Bottom", prev: "i", next: ""},
+		{name: "Apart", prev: "a", next: "z"},
+		{name: "Consecutive", prev: "a", next: "b"},
+		{name: "Prefix", prev: "a", next: "a1"},
+		{name: "CommonPrefix", prev: "ab1", next: "ab2"},
+		{name: "LongerPrev", prev: "azzz", next: "b"},
+		{name: "BottomOfLastDigit", prev: "z", next: ""},
+		{name: "TopOfFirstDigit", prev: "", next: "01"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			rank, err := RankBetween(tt.prev, tt.next)
+			if err != nil {
+				t.Fatalf("RankBetween() error = %v", err)
+			}
+
+			assertBetween(t, rank, tt.prev, tt.next)
+		})
+	}
+
+}
+
+func TestRankBetweenInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		prev string
+		next string
+	}{
+		{name: "InvalidDigit", prev: "A", next: ""},
+		{name: "TrailingZero", prev: "", next: "a0"},
+		{name: "Equal", prev: "a", next: "a"},
+		{name: "Reversed", prev: "b", next: "a"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if rank, err := RankBetween(tt.prev, tt.next); err == nil {
+				t.Errorf("RankBetween() = %q, want an error", rank)
+			}
+		})
+	}
+
+}
+
+func TestRankBetweenRepeated(t *testing.T) {
+	// Moving favorites to the same place over and over keeps finding ranks in between.
+	prev, next := "a", "b"
+	for i := 0; i < 200; i++ {
+		rank, err := RankBetween(prev, next)
+		if err != nil {
+			t.Fatalf("RankBetween() error = %v", err)
+		}
+
+		assertBetween(t, rank, prev, next)
+		if i%2 == 0 {
+			next = rank
+		} else {
+			prev = rank
+		}
+	}
+
+	top := "a"
+	for i := 0; i < 200; i++ {
+		rank, err := RankBetween("", top)
+		if err != nil {
+			t.Fatalf("RankBetween() error = %v", err)
+		}
+
+		assertBetween(t, rank, "", top)
+		top = rank
+	}
+
+}
+
+func TestDefaultRank(t *testing.T) {
+	now := time.Now()
+	older := DefaultRank(now, "2")
+	newer := DefaultRank(now.Add(time.Millisecond), "1")
+	tied := DefaultRank(now, "3")
+
+	if !(newer < older && older < tied) {
+		t.Errorf("DefaultRank() = %q, %q, %q, want newest first and ties ordered by tiebreak", newer, older, tied)
+	}
+
+	for _, rank := range []string{older, newer, tied} {
+		if !validRank(rank) {
+			t.Errorf("DefaultRank() = %q, want a valid rank", rank)
+		}
+	}
+
+}
+
+func TestMoveRank(t *testing.T) {
+	pinnedA := &favoriteValue{itemID: "pinnedA", rank: "a", pinned: true}
+	pinnedB := &favoriteValue{itemID: "pinnedB", rank: "c", pinned: true}
+	first := &favoriteValue{itemID: "first", rank: "b"}
+	second := &favoriteValue{itemID: "second", rank: "d"}
+	moved := &favoriteValue{itemID: "moved", rank: "e"}
+
+	tests := []struct {
+		name     string
+		previous Favorite
+		next     Favorite
+		prev     string
+		nextRank string
+	}{
+		{name: "Between", previous: first, next: second, prev: "b", nextRank: "d"},
+		{name: "Top", previous: nil, next: first, prev: "", nextRank: "b"},
+		{name: "Bottom", previous: second, next: nil, prev: "d", nextRank: ""},
+		{name: "BelowPinned", previous: pinnedB, next: first, prev: "", nextRank: "b"},
+		{name: "AbovePinned", previous: second, next: pinnedA, prev: "d", nextRank: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			rank, err := MoveRank(moved, tt.previous, tt.next)
+			if err != nil {
+				t.Fatalf("MoveRank() error = %v", err)
+			}
+
+			assertBetween(t, rank, tt.prev, tt.nextRank)
+		})
+	}
+
+}
+
+func TestMoveRankReversedNeighbors(t *testing.T) {
+	previous := &favoriteValue{itemID: "previous", rank: "d"}
+	next := &favoriteValue{itemID: "next", rank: "b"}
+
+	_, err := MoveRank(&favoriteValue{itemID: "moved", rank: "e"}, previous, next)
+
+	var serviceErr *Error
+	if !errors.As(err, &serviceErr) || serviceErr.Kind != KindAborted {
+		t.Errorf("MoveRank() error = %v, want an aborted error", err)
+	}
+
+}
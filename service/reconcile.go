@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// The actions taken on orphaned favorites, the favorites of files that don't exist anymore.
+const (
+	// ReconcileDelete deletes orphaned favorites.
+	ReconcileDelete = "delete"
+
+	// ReconcileFlag hides orphaned favorites like the favorites of trashed files,
+	// so they're shown again if the file is found again.
+	ReconcileFlag = "flag"
+)
+
+// DefaultReconcileBatchSize is the number of favorites read in each batch of a reconciliation without a batch size.
+const DefaultReconcileBatchSize = 500
+
+// FileExistenceChecker is an interface for checking which files still exist.
+type FileExistenceChecker interface {
+	// Exist returns whether each of the files of fileIDs exists, in their order.
+	Exist(ctx context.Context, fileIDs []string) ([]bool, error)
+}
+
+// ReconcileOptions are the options of reconciling orphaned favorites.
+type ReconcileOptions struct {
+	// Action is taken on orphaned favorites, either ReconcileDelete or ReconcileFlag.
+	Action string
+
+	// DryRun finds orphaned favorites without taking Action.
+	DryRun bool
+
+	// BatchSize is the number of favorites read in each batch, DefaultReconcileBatchSize if 0.
+	BatchSize int
+
+	// BatchDelay is the duration to wait between batches, which limits the rate of the requests
+	// to the store and the file existence checker.
+	BatchDelay time.Duration
+}
+
+// ReconcileReport is the summary of reconciling orphaned favorites.
+type ReconcileReport struct {
+	Action string
+	DryRun bool
+
+	Batches          int64
+	ScannedFavorites int64
+
+	// CheckedFiles is the number of distinct files checked in each batch.
+	CheckedFiles int64
+
+	// OrphanedFiles is the number of checked files that don't exist.
+	OrphanedFiles int64
+
+	// OrphanedFavorites is the number of scanned favorites of files that don't exist.
+	OrphanedFavorites int64
+
+	// ReconciledFavorites is the number of favorites that Action was taken on, 0 in a dry run.
+	// Action is taken on all of the favorites of an orphaned file, including favorites that weren't scanned yet.
+	ReconciledFavorites int64
+
+	// UnflaggedFavorites is the number of favorites flagged as orphaned that were shown again
+	// since their files exist again, 0 in a dry run.
+	UnflaggedFavorites int64
+
+	Duration time.Duration
+}
+
+// ReconcileOrphans walks the favorites of store matching flaggedFilter, the favorites that were flagged as
+// orphaned, in batches of opts.BatchSize favorites in file ID order, checks which of their files exist with
+// checker, and shows the favorites of the files that exist again with unflag, which returns the number of
+// favorites it showed. It then walks the favorites matching filter, which should match only active favorites,
+// the same way and takes opts.Action on the favorites of the files that don't exist with reconcile, which
+// returns the number of favorites it took the action on. Returns the report of the reconciliation,
+// which is partial if it fails.
+func ReconcileOrphans(
+	ctx context.Context,
+	store Store,
+	checker FileExistenceChecker,
+	opts ReconcileOptions,
+	filter interface{},
+	reconcile func(ctx context.Context, fileIDs []string) (int64, error),
+	flaggedFilter interface{},
+	unflag func(ctx context.Context, fileIDs []string) (int64, error),
+) (report ReconcileReport, err error) {
+	if opts.Action != ReconcileDelete && opts.Action != ReconcileFlag {
+		return ReconcileReport{}, fmt.Errorf("unknown reconcile action %q, must be %q or %q", opts.Action, ReconcileDelete, ReconcileFlag)
+	}
+
+	started := time.Now()
+	report = ReconcileReport{Action: opts.Action, DryRun: opts.DryRun}
+	defer func() {
+		report.Duration = time.Since(started)
+	}()
+
+	// The flagged favorites are walked first, so the favorites flagged by this reconciliation aren't checked again.
+	err = reconcileBatches(ctx, store, opts, flaggedFilter, &report, func(favorites []Favorite) error {
+		return unflagBatch(ctx, checker, opts, favorites, unflag, &report)
+	})
+	if err != nil {
+		return report, err
+	}
+
+	err = reconcileBatches(ctx, store, opts, filter, &report, func(favorites []Favorite) error {
+		return reconcileBatch(ctx, checker, opts, favorites, reconcile, &report)
+	})
+
+	return report, err
+
+}
+
+// reconcileBatches reads the favorites of store matching filter in batches of opts.BatchSize favorites in
+// file ID order, waiting opts.BatchDelay between batches, and calls fn with each batch, adding them to report.
+func reconcileBatches(
+	ctx context.Context,
+	store Store,
+	opts ReconcileOptions,
+	filter interface{},
+	report *ReconcileReport,
+	fn func(favorites []Favorite) error,
+) error {
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultReconcileBatchSize
+	}
+
+	pageToken := ""
+	for {
+		if report.Batches > 0 && opts.BatchDelay > 0 {
+			select {
+			case <-time.After(opts.BatchDelay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		favorites, nextPageToken, err := store.GetAll(ctx, filter, ListOptions{Sort: SortByFileID, PageSize: batchSize, PageToken: pageToken})
+		if err != nil {
+			return fmt.Errorf("failed reading favorites: %w", err)
+		}
+
+		report.Batches++
+		report.ScannedFavorites += int64(len(favorites))
+		if err := fn(favorites); err != nil {
+			return err
+		}
+
+		if nextPageToken == "" {
+			return nil
+		}
+
+		pageToken = nextPageToken
+	}
+
+}
+
+// reconcileBatch checks which of the files of favorites exist with checker and takes opts.Action on the
+// favorites of the files that don't exist with reconcile, adding the outcome to report.
+func reconcileBatch(
+	ctx context.Context,
+	checker FileExistenceChecker,
+	opts ReconcileOptions,
+	favorites []Favorite,
+	reconcile func(ctx context.Context, fileIDs []string) (int64, error),
+	report *ReconcileReport,
+) error {
+	fileIDs, favoriteCounts, exist, err := checkFiles(ctx, checker, favorites, report)
+	if err != nil {
+		return err
+	}
+
+	orphanedFileIDs := make([]string, 0, len(fileIDs))
+	for i, fileID := range fileIDs {
+		if !exist[i] {
+			orphanedFileIDs = append(orphanedFileIDs, fileID)
+			report.OrphanedFavorites += favoriteCounts[fileID]
+		}
+	}
+
+	report.OrphanedFiles += int64(len(orphanedFileIDs))
+	if len(orphanedFileIDs) == 0 || opts.DryRun {
+		return nil
+	}
+
+	reconciledCount, err := reconcile(ctx, orphanedFileIDs)
+	if err != nil {
+		return fmt.Errorf("failed reconciling orphaned favorites: %w", err)
+	}
+
+	report.ReconciledFavorites += reconciledCount
+
+	return nil
+
+}
+
+// unflagBatch checks which of the files of favorites, which were flagged as orphaned, exist with checker
+// and shows the favorites of the files that exist again with unflag, adding the outcome to report.
+func unflagBatch(
+	ctx context.Context,
+	checker FileExistenceChecker,
+	opts ReconcileOptions,
+	favorites []Favorite,
+	unflag func(ctx context.Context, fileIDs []string) (int64, error),
+	report *ReconcileReport,
+) error {
+	fileIDs, _, exist, err := checkFiles(ctx, checker, favorites, report)
+	if err != nil {
+		return err
+	}
+
+	foundFileIDs := make([]string, 0, len(fileIDs))
+	for i, fileID := range fileIDs {
+		if exist[i] {
+			foundFileIDs = append(foundFileIDs, fileID)
+		}
+	}
+
+	if len(foundFileIDs) == 0 || opts.DryRun {
+		return nil
+	}
+
+	unflaggedCount, err := unflag(ctx, foundFileIDs)
+	if err != nil {
+		return fmt.Errorf("failed showing flagged favorites: %w", err)
+	}
+
+	report.UnflaggedFavorites += unflaggedCount
+
+	return nil
+
+}
+
+// checkFiles checks whether each of the distinct files of favorites exists with checker, adding them to report.
+// Returns the file IDs of the files, the number of favorites of each of them and whether each of them exists.
+func checkFiles(
+	ctx context.Context,
+	checker FileExistenceChecker,
+	favorites []Favorite,
+	report *ReconcileReport,
+) ([]string, map[string]int64, []bool, error) {
+	// favoriteCounts holds the number of favorites of each file in the batch.
+	favoriteCounts := make(map[string]int64)
+	fileIDs := make([]string, 0, len(favorites))
+	for _, favorite := range favorites {
+		if favoriteCounts[favorite.GetFileID()] == 0 {
+			fileIDs = append(fileIDs, favorite.GetFileID())
+		}
+
+		favoriteCounts[favorite.GetFileID()]++
+	}
+
+	if len(fileIDs) == 0 {
+		return nil, nil, nil, nil
+	}
+
+	exist, err := checker.Exist(ctx, fileIDs)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed checking files existence: %w", err)
+	}
+
+	report.CheckedFiles += int64(len(fileIDs))
+
+	return fileIDs, favoriteCounts, exist, nil
+
+}
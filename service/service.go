@@ -3,40 +3,658 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	pb "github.com/meateam/fav-service/proto"
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// MaxPageSize is the maximum number of favorites returned in a single page.
+	MaxPageSize = 1000
+
+	// MaxBatchSize is the maximum number of fileIDs in a single batch request.
+	MaxBatchSize = 1000
+
+	// MaxCollectionsPerRequest is the maximum number of collectionIDs in a single request.
+	MaxCollectionsPerRequest = 100
+)
+
+// sortOrders maps the requested pb.SortBy to the SortOrder of the listed favorites.
+var sortOrders = map[pb.SortBy]SortOrder{
+	pb.SortBy_OLDEST_FIRST: SortOldestFirst,
+	pb.SortBy_NEWEST_FIRST: SortNewestFirst,
+	pb.SortBy_FILE_ID:      SortByFileID,
+	pb.SortBy_MANUAL:       SortManual,
+}
+
 // Service is a structure used for handling favorite Service grpc requests.
 type Service struct {
 	controller Controller
 	logger     *logrus.Logger
+
+	// authorizer checks whether users may access the items they favorite.
+	authorizer Authorizer
+
+	// filterFavorites is set if the favorites of items that users may no longer access are left out of
+	// GetAllFavorites responses.
+	filterFavorites bool
 	pb.UnimplementedFavoriteServer
 }
 
-// NewService creates a Service and returns it.
-func NewService(controller Controller, logger *logrus.Logger) Service {
-	return Service{controller: controller, logger: logger}
+// NewService creates a Service and returns it. Users may only favorite the items that authorizer
+// allows them to access, and if filterFavorites is set, GetAllFavorites only returns such favorites.
+func NewService(controller Controller, authorizer Authorizer, filterFavorites bool, logger *logrus.Logger) Service {
+	return Service{controller: controller, authorizer: authorizer, filterFavorites: filterFavorites, logger: logger}
+
+}
+
+// CreateFavorite is the request handler for creating a favorite.
+// If req.Idempotent is set, creating an existing favorite returns the existing favorite.
+// The favorited item is req.ItemType and req.ItemID, or the file of req.FileID.
+// If req.ExpiresAt is set, the favorite expires at that time.
+// Returns a PermissionDenied error if the user may not access the item.
+func (s Service) CreateFavorite(ctx context.Context, req *pb.CreateFavoriteRequest) (*pb.FavoriteObject, error) {
+	userID := req.GetUserID()
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	itemType, itemID, err := requestItem(req.GetItemType(), req.GetItemID(), req.GetFileID())
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt time.Time
+	if req.GetExpiresAt() < 0 {
+		return nil, NewValidationError("expiresAt", "expiresAt must not be negative")
+	}
+
+	if req.GetExpiresAt() > 0 {
+		expiresAt = time.Unix(0, req.GetExpiresAt()*int64(time.Millisecond)).UTC()
+		if !expiresAt.After(time.Now()) {
+			return nil, NewValidationError("expiresAt", "expiresAt must be in the future")
+		}
+	}
+
+	authorized, err := s.authorize(ctx, userID, itemType, []string{itemID})
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorized[itemID] {
+		return nil, NewItemAccessDeniedError(itemType, itemID, userID)
+	}
+
+	favorite, err := s.controller.CreateFavorite(ctx, itemType, itemID, userID, expiresAt, req.GetIdempotent())
+	if err != nil {
+		return nil, err
+	}
+
+	var response pb.FavoriteObject
+	if err := favorite.MarshalProto(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+
+}
+
+// DeleteFavorite is the request handler for deleting favorite.
+// The favorited item is req.ItemType and req.ItemID, or the file of req.FileID.
+// The deleted favorite may be restored with RestoreFavorite within the restore window.
+func (s Service) DeleteFavorite(ctx context.Context, req *pb.DeleteFavoriteRequest) (*pb.FavoriteObject, error) {
+	userID := req.GetUserID()
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	itemType, itemID, err := requestItem(req.GetItemType(), req.GetItemID(), req.GetFileID())
+	if err != nil {
+		return nil, err
+	}
+
+	favorite, err := s.controller.DeleteFavorite(ctx, itemType, itemID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var response pb.FavoriteObject
+	if err := favorite.MarshalProto(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+
+}
+
+// GetAllFavorites is the request handler for getting all user favorite files.
+// If req.PageSize is set, the favorites are returned in pages of at most MaxPageSize favorites.
+// If req.CollectionID, req.Tag or req.ItemType are set, only the favorites in that collection,
+// with that tag or of items of that type are returned.
+// If the service filters favorites, the favorites of items the user may no longer access are left out,
+// so a page may have fewer favorites than req.PageSize even if there are more.
+func (s Service) GetAllFavorites(ctx context.Context, req *pb.GetAllFavoritesRequest) (*pb.GetAllFavoritesResponse, error) {
+	userID := req.GetUserID()
+	pageSize := int(req.GetPageSize())
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	if pageSize < 0 {
+		return nil, NewValidationError("pageSize", "pageSize must not be negative")
+	}
+
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	sort, ok := sortOrders[req.GetSortBy()]
+	if !ok {
+		return nil, NewValidationError("sortBy", fmt.Sprintf("unknown sortBy %v", req.GetSortBy()))
+	}
+
+	opts := ListOptions{Sort: sort, PageSize: pageSize, PageToken: req.GetPageToken()}
+	if req.GetItemType() != "" && !itemTypes[req.GetItemType()] {
+		return nil, NewValidationError("itemType", fmt.Sprintf("unknown itemType %q", req.GetItemType()))
+	}
+
+	query := FavoriteQuery{
+		CollectionID: req.GetCollectionID(),
+		Tag:          strings.TrimSpace(req.GetTag()),
+		ItemType:     req.GetItemType(),
+	}
+	favorites, nextPageToken, err := s.controller.GetAllFavorites(ctx, userID, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.filterFavorites {
+		if favorites, err = s.authorizedFavorites(ctx, userID, favorites); err != nil {
+			return nil, err
+		}
+	}
+
+	response := &pb.GetAllFavoritesResponse{
+		FavFileIDList: make([]string, 0, len(favorites)),
+		Favorites:     make([]*pb.FavoriteObject, 0, len(favorites)),
+		NextPageToken: nextPageToken,
+	}
+
+	for _, favorite := range favorites {
+		var favoriteObject pb.FavoriteObject
+		if err := favorite.MarshalProto(&favoriteObject); err != nil {
+			return nil, err
+		}
+
+		if favorite.GetItemType() == ItemTypeFile {
+			response.FavFileIDList = append(response.FavFileIDList, favorite.GetFileID())
+		}
+
+		response.Favorites = append(response.Favorites, &favoriteObject)
+	}
+
+	return response, nil
+
+}
+
+// ListFavorites is the request handler for streaming all user favorite files.
+// The favorites are streamed as they're read from the store, and streaming stops
+// when the client cancels the request.
+func (s Service) ListFavorites(req *pb.ListFavoritesRequest, stream pb.Favorite_ListFavoritesServer) error {
+	userID := req.GetUserID()
+
+	if userID == "" {
+		return NewValidationError("userID", "userID is required")
+	}
+
+	sort, ok := sortOrders[req.GetSortBy()]
+	if !ok {
+		return NewValidationError("sortBy", fmt.Sprintf("unknown sortBy %v", req.GetSortBy()))
+	}
+
+	return s.controller.ListFavorites(stream.Context(), userID, sort, func(favorite Favorite) error {
+		var response pb.FavoriteObject
+		if err := favorite.MarshalProto(&response); err != nil {
+			return err
+		}
+
+		return stream.Send(&response)
+	})
+
+}
+
+// WatchFavorites is the request handler for streaming the changes of a user's favorites as they happen,
+// after the change of the request's resume token if it's set.
+func (s Service) WatchFavorites(req *pb.WatchFavoritesRequest, stream pb.Favorite_WatchFavoritesServer) error {
+	userID := req.GetUserID()
+
+	if userID == "" {
+		return NewValidationError("userID", "userID is required")
+	}
+
+	return s.controller.WatchFavorites(stream.Context(), userID, req.GetResumeToken(), func(change Change) error {
+		var response pb.FavoriteChange
+		if err := change.MarshalProto(&response); err != nil {
+			return err
+		}
+
+		return stream.Send(&response)
+	})
+
+}
+
+// IsFavorite is the request handler for checking which of the given files are user favorites.
+func (s Service) IsFavorite(ctx context.Context, req *pb.IsFavoriteRequest) (*pb.IsFavoriteResponse, error) {
+	userID := req.GetUserID()
+	fileIDs := req.GetFileIDs()
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	if err := validateFileIDs(fileIDs); err != nil {
+		return nil, err
+	}
+
+	isFavorite, err := s.controller.IsFavorite(ctx, userID, fileIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.IsFavoriteResponse{IsFavorite: isFavorite}, nil
+
+}
+
+// CreateFavorites is the request handler for creating many favorites of a user.
+// An item that fails doesn't fail the others, the result of each item is returned.
+// The favorites of items the user may not access aren't created.
+func (s Service) CreateFavorites(ctx context.Context, req *pb.CreateFavoritesRequest) (*pb.BatchFavoritesResponse, error) {
+	userID := req.GetUserID()
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	itemType, itemIDs, err := requestItems(req.GetItemType(), req.GetItemIDs(), req.GetFileIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	authorized, err := s.authorize(ctx, userID, itemType, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizedItemIDs := make([]string, 0, len(itemIDs))
+	for _, itemID := range itemIDs {
+		if authorized[itemID] {
+			authorizedItemIDs = append(authorizedItemIDs, itemID)
+		}
+	}
+
+	var authorizedResults []BatchResult
+	if len(authorizedItemIDs) > 0 {
+		authorizedResults, err = s.controller.CreateFavorites(ctx, userID, itemType, authorizedItemIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]BatchResult, 0, len(itemIDs))
+	for _, itemID := range itemIDs {
+		if !authorized[itemID] {
+			results = append(results, BatchResult{ItemType: itemType, ItemID: itemID, Status: BatchPermissionDenied})
+			continue
+		}
+
+		results = append(results, authorizedResults[0])
+		authorizedResults = authorizedResults[1:]
+	}
+
+	return marshalBatchResults(results)
+
+}
+
+// DeleteFavorites is the request handler for deleting many favorites of a user.
+// An item that fails doesn't fail the others, the result of each item is returned.
+func (s Service) DeleteFavorites(ctx context.Context, req *pb.DeleteFavoritesRequest) (*pb.BatchFavoritesResponse, error) {
+	userID := req.GetUserID()
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	itemType, itemIDs, err := requestItems(req.GetItemType(), req.GetItemIDs(), req.GetFileIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.controller.DeleteFavorites(ctx, userID, itemType, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalBatchResults(results)
+
+}
+
+// GetFileFavoriters is the request handler for getting the users that favorited a file.
+// If req.PageSize is set, the users are returned in pages of at most MaxPageSize users.
+func (s Service) GetFileFavoriters(ctx context.Context, req *pb.GetFileFavoritersRequest) (*pb.GetFileFavoritersResponse, error) {
+	fileID := req.GetFileID()
+	pageSize := int(req.GetPageSize())
+
+	if fileID == "" {
+		return nil, NewValidationError("fileID", "fileID is required")
+	}
+
+	if pageSize < 0 {
+		return nil, NewValidationError("pageSize", "pageSize must not be negative")
+	}
+
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	opts := ListOptions{Sort: SortOldestFirst, PageSize: pageSize, PageToken: req.GetPageToken()}
+	favorites, nextPageToken, err := s.controller.GetFileFavoriters(ctx, fileID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0, len(favorites))
+	for _, favorite := range favorites {
+		userIDs = append(userIDs, favorite.GetUserID())
+	}
+
+	return &pb.GetFileFavoritersResponse{UserIDs: userIDs, NextPageToken: nextPageToken}, nil
+
+}
+
+// CountFileFavorites is the request handler for counting the users that favorited a file.
+func (s Service) CountFileFavorites(ctx context.Context, req *pb.CountFileFavoritesRequest) (*pb.CountFileFavoritesResponse, error) {
+	fileID := req.GetFileID()
+
+	if fileID == "" {
+		return nil, NewValidationError("fileID", "fileID is required")
+	}
+
+	count, err := s.controller.CountFileFavorites(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CountFileFavoritesResponse{Count: count}, nil
+
+}
+
+// DeleteFavoritesByFile is the request handler for deleting the favorites of a file of all users,
+// used to clean up the favorites of a deleted file.
+func (s Service) DeleteFavoritesByFile(ctx context.Context, req *pb.DeleteFavoritesByFileRequest) (*pb.DeleteFavoritesByFileResponse, error) {
+	fileID := req.GetFileID()
+
+	if fileID == "" {
+		return nil, NewValidationError("fileID", "fileID is required")
+	}
+
+	deletedCount, err := s.controller.DeleteFavoritesByFiles(ctx, []string{fileID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteFavoritesByFileResponse{DeletedCount: deletedCount}, nil
+
+}
+
+// DeleteFavoritesByFiles is the request handler for deleting the favorites of many files of all users,
+// used to clean up the favorites of deleted files.
+func (s Service) DeleteFavoritesByFiles(ctx context.Context, req *pb.DeleteFavoritesByFilesRequest) (*pb.DeleteFavoritesByFileResponse, error) {
+	fileIDs := req.GetFileIDs()
+
+	if len(fileIDs) == 0 {
+		return nil, NewValidationError("fileIDs", "fileIDs is required")
+	}
+
+	if err := validateFileIDs(fileIDs); err != nil {
+		return nil, err
+	}
+
+	deletedCount, err := s.controller.DeleteFavoritesByFiles(ctx, fileIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteFavoritesByFileResponse{DeletedCount: deletedCount}, nil
+
+}
+
+// DeleteAllUserFavorites is the request handler for deleting all of the favorites of a user along with
+// its collections and quota override, used for offboarding and erasure requests.
+// The deletion is recorded in an audit record.
+func (s Service) DeleteAllUserFavorites(ctx context.Context, req *pb.DeleteAllUserFavoritesRequest) (*pb.DeleteAllUserFavoritesResponse, error) {
+	userID := req.GetUserID()
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	deletedCount, err := s.controller.DeleteAllUserFavorites(ctx, userID, req.GetReason(), req.GetRequestedBy())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAllUserFavoritesResponse{DeletedCount: deletedCount}, nil
+
+}
+
+// TransferFavorites is the request handler for moving all of the favorites of a user to another user,
+// used when accounts are merged or a user's ID changes. Favorites that the target user already has
+// are skipped, so a failed transfer may be retried.
+func (s Service) TransferFavorites(ctx context.Context, req *pb.TransferFavoritesRequest) (*pb.TransferFavoritesResponse, error) {
+	sourceUserID := req.GetSourceUserID()
+	targetUserID := req.GetTargetUserID()
+
+	if sourceUserID == "" {
+		return nil, NewValidationError("sourceUserID", "sourceUserID is required")
+	}
+
+	if targetUserID == "" {
+		return nil, NewValidationError("targetUserID", "targetUserID is required")
+	}
+
+	if sourceUserID == targetUserID {
+		return nil, NewValidationError("targetUserID", "targetUserID must be different from sourceUserID")
+	}
+
+	movedCount, skippedCount, err := s.controller.TransferFavorites(ctx, sourceUserID, targetUserID, req.GetKeepSource())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TransferFavoritesResponse{MovedCount: movedCount, SkippedCount: skippedCount}, nil
+
+}
+
+// CreateCollection is the request handler for creating a named collection of favorites of a user.
+func (s Service) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.CollectionObject, error) {
+	userID := req.GetUserID()
+	name := req.GetName()
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	if err := validateCollectionName(name); err != nil {
+		return nil, err
+	}
+
+	collection, err := s.controller.CreateCollection(ctx, userID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var response pb.CollectionObject
+	if err := collection.MarshalProto(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+
+}
+
+// GetCollections is the request handler for getting all of the collections of a user.
+func (s Service) GetCollections(ctx context.Context, req *pb.GetCollectionsRequest) (*pb.GetCollectionsResponse, error) {
+	userID := req.GetUserID()
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	collections, err := s.controller.GetCollections(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetCollectionsResponse{Collections: make([]*pb.CollectionObject, 0, len(collections))}
+	for _, collection := range collections {
+		var collectionObject pb.CollectionObject
+		if err := collection.MarshalProto(&collectionObject); err != nil {
+			return nil, err
+		}
+
+		response.Collections = append(response.Collections, &collectionObject)
+	}
+
+	return response, nil
+
+}
+
+// UpdateCollection is the request handler for renaming a collection of a user.
+func (s Service) UpdateCollection(ctx context.Context, req *pb.UpdateCollectionRequest) (*pb.CollectionObject, error) {
+	userID := req.GetUserID()
+	collectionID := req.GetCollectionID()
+	name := req.GetName()
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	if collectionID == "" {
+		return nil, NewValidationError("collectionID", "collectionID is required")
+	}
+
+	if err := validateCollectionName(name); err != nil {
+		return nil, err
+	}
+
+	collection, err := s.controller.RenameCollection(ctx, userID, collectionID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var response pb.CollectionObject
+	if err := collection.MarshalProto(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+
+}
+
+// DeleteCollection is the request handler for deleting a collection of a user.
+// The favorites in the collection aren't deleted, they're only removed from it.
+func (s Service) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*pb.CollectionObject, error) {
+	userID := req.GetUserID()
+	collectionID := req.GetCollectionID()
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	if collectionID == "" {
+		return nil, NewValidationError("collectionID", "collectionID is required")
+	}
+
+	collection, err := s.controller.DeleteCollection(ctx, userID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var response pb.CollectionObject
+	if err := collection.MarshalProto(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+
+}
+
+// AddToCollections is the request handler for adding favorites of a user to some of their collections.
+// An item that isn't a favorite of the user doesn't fail the others, the result of each item is returned.
+func (s Service) AddToCollections(ctx context.Context, req *pb.CollectionFavoritesRequest) (*pb.BatchFavoritesResponse, error) {
+	userID := req.GetUserID()
+	collectionIDs := req.GetCollectionIDs()
+
+	itemType, itemIDs, err := validateCollectionFavorites(req)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.controller.AddToCollections(ctx, userID, itemType, itemIDs, collectionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalBatchResults(results)
 
 }
 
+// RemoveFromCollections is the request handler for removing favorites of a user from some of their collections.
+// An item that isn't a favorite of the user doesn't fail the others, the result of each item is returned.
+func (s Service) RemoveFromCollections(ctx context.Context, req *pb.CollectionFavoritesRequest) (*pb.BatchFavoritesResponse, error) {
+	userID := req.GetUserID()
+	collectionIDs := req.GetCollectionIDs()
 
-// CreateFavorite is the request handler for creating a favorite. 
-func (s Service) CreateFavorite(ctx context.Context, req *pb.CreateFavoriteRequest,) (*pb.FavoriteObject, error) {
-	fileID := req.GetFileID()
+	itemType, itemIDs, err := validateCollectionFavorites(req)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.controller.RemoveFromCollections(ctx, userID, itemType, itemIDs, collectionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalBatchResults(results)
+
+}
+
+// UpdateFavorite is the request handler for updating the tags, note and pinned flag of a favorite.
+// Only the fields in req.UpdateMask are updated, or the tags and note if it's empty.
+// Tags are trimmed and deduplicated.
+func (s Service) UpdateFavorite(ctx context.Context, req *pb.UpdateFavoriteRequest) (*pb.FavoriteObject, error) {
 	userID := req.GetUserID()
 
 	if userID == "" {
-		return nil, fmt.Errorf("userID is required")
+		return nil, NewValidationError("userID", "userID is required")
 	}
 
-	if fileID == "" {
-		return nil, fmt.Errorf("fileID is required")
+	itemType, itemID, err := requestItem(req.GetItemType(), req.GetItemID(), req.GetFileID())
+	if err != nil {
+		return nil, err
+	}
+
+	update, err := favoriteUpdate(req)
+	if err != nil {
+		return nil, err
 	}
 
-	favorite, err := s.controller.CreateFavorite(ctx, fileID, userID)
+	favorite, err := s.controller.UpdateFavorite(ctx, itemType, itemID, userID, update)
 	if err != nil {
 		return nil, err
 	}
@@ -50,20 +668,67 @@ func (s Service) CreateFavorite(ctx context.Context, req *pb.CreateFavoriteReque
 
 }
 
-// DeleteFavorite is the request handler for deleting favorite.
-func (s Service) DeleteFavorite(ctx context.Context, req *pb.DeleteFavoriteRequest,) (*pb.FavoriteObject, error) {
-	fileID := req.GetFileID()
+// ReorderFavorites is the request handler for moving a favorite of a user between two neighbors
+// in the order set by the user, only the rank of the moved favorite changes.
+func (s Service) ReorderFavorites(ctx context.Context, req *pb.ReorderFavoritesRequest) (*pb.FavoriteObject, error) {
 	userID := req.GetUserID()
 
 	if userID == "" {
-		return nil, fmt.Errorf("userID is required")
+		return nil, NewValidationError("userID", "userID is required")
 	}
 
-	if fileID == "" {
-		return nil, fmt.Errorf("fileID is required")
+	itemType, itemID, err := requestItem(req.GetItemType(), req.GetItemID(), req.GetFileID())
+	if err != nil {
+		return nil, err
+	}
+
+	previousItemID, err := neighborItemID(itemType, req.GetPreviousItemID(), req.GetPreviousFileID(), "previousItemID")
+	if err != nil {
+		return nil, err
+	}
+
+	nextItemID, err := neighborItemID(itemType, req.GetNextItemID(), req.GetNextFileID(), "nextItemID")
+	if err != nil {
+		return nil, err
+	}
+
+	if previousItemID == "" && nextItemID == "" {
+		return nil, NewValidationError("nextItemID", "previousItemID or nextItemID is required")
+	}
+
+	if previousItemID == itemID || nextItemID == itemID || (previousItemID != "" && previousItemID == nextItemID) {
+		return nil, NewValidationError("nextItemID", "itemID, previousItemID and nextItemID must be different")
+	}
+
+	favorite, err := s.controller.ReorderFavorite(ctx, userID, itemType, itemID, previousItemID, nextItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	var response pb.FavoriteObject
+	if err := favorite.MarshalProto(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+
+}
+
+// RestoreFavorite is the request handler for restoring a deleted favorite within the restore window.
+// The favorited item is req.ItemType and req.ItemID, or the file of req.FileID.
+func (s Service) RestoreFavorite(ctx context.Context, req *pb.RestoreFavoriteRequest) (*pb.FavoriteObject, error) {
+	userID := req.GetUserID()
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	itemType, itemID, err := requestItem(req.GetItemType(), req.GetItemID(), req.GetFileID())
+	if err != nil {
+		return nil, err
 	}
 
-	favorite, err := s.controller.DeleteFavorite(ctx, fileID, userID)
+	favorite, err := s.controller.RestoreFavorite(ctx, itemType, itemID, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -77,23 +742,136 @@ func (s Service) DeleteFavorite(ctx context.Context, req *pb.DeleteFavoriteReque
 
 }
 
-// GetAllFavorites is the request handler for getting all user favorite files.  
-func (s Service) GetAllFavorites(ctx context.Context, req *pb.GetAllFavoritesRequest,) (*pb.GetAllFavoritesResponse, error) {
+// ListRecentlyDeletedFavorites is the request handler for listing the favorites of a user that were
+// deleted within the restore window, most recently deleted first.
+// If req.PageSize is set, the favorites are returned in pages of at most MaxPageSize favorites.
+func (s Service) ListRecentlyDeletedFavorites(
+	ctx context.Context,
+	req *pb.ListRecentlyDeletedFavoritesRequest,
+) (*pb.ListRecentlyDeletedFavoritesResponse, error) {
+	userID := req.GetUserID()
+	pageSize := int(req.GetPageSize())
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	if pageSize < 0 {
+		return nil, NewValidationError("pageSize", "pageSize must not be negative")
+	}
+
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	opts := ListOptions{Sort: SortRecentlyDeleted, PageSize: pageSize, PageToken: req.GetPageToken()}
+	favorites, nextPageToken, err := s.controller.GetRecentlyDeletedFavorites(ctx, userID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListRecentlyDeletedFavoritesResponse{
+		Favorites:     make([]*pb.FavoriteObject, 0, len(favorites)),
+		NextPageToken: nextPageToken,
+	}
+
+	for _, favorite := range favorites {
+		var favoriteObject pb.FavoriteObject
+		if err := favorite.MarshalProto(&favoriteObject); err != nil {
+			return nil, err
+		}
+
+		response.Favorites = append(response.Favorites, &favoriteObject)
+	}
+
+	return response, nil
+
+}
+
+// GetFavoriteQuota is the request handler for getting the number of favorites of a user
+// and the maximum number of favorites it may have.
+func (s Service) GetFavoriteQuota(ctx context.Context, req *pb.GetFavoriteQuotaRequest) (*pb.FavoriteQuota, error) {
+	userID := req.GetUserID()
+
+	if userID == "" {
+		return nil, NewValidationError("userID", "userID is required")
+	}
+
+	quota, err := s.controller.GetFavoriteQuota(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var response pb.FavoriteQuota
+	if err := quota.MarshalProto(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+
+}
+
+// SetFavoriteQuota is the request handler for overriding the maximum number of favorites of a user,
+// a limit of 0 restores the default limit and a limit of UnlimitedQuota makes the user unlimited.
+func (s Service) SetFavoriteQuota(ctx context.Context, req *pb.SetFavoriteQuotaRequest) (*pb.FavoriteQuota, error) {
 	userID := req.GetUserID()
 
 	if userID == "" {
-		return nil, fmt.Errorf("userID is required")
+		return nil, NewValidationError("userID", "userID is required")
 	}
 
-	favorite, err := s.controller.GetAllFavorites(ctx, userID)
+	if req.GetLimit() < UnlimitedQuota {
+		return nil, NewValidationError("limit", fmt.Sprintf("limit must not be negative, other than %d for no limit", UnlimitedQuota))
+	}
+
+	quota, err := s.controller.SetFavoriteQuota(ctx, userID, req.GetLimit())
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.GetAllFavoritesResponse{FavFileIDList: favorite}, nil
+	var response pb.FavoriteQuota
+	if err := quota.MarshalProto(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+
+}
+
+// ExpireFavorites deletes the favorites that have expired, so they're purged once they were deleted
+// before the restore window, and returns the number of expired favorites.
+func (s Service) ExpireFavorites(ctx context.Context) (int64, error) {
+	return s.controller.ExpireFavorites(ctx)
+
+}
+
+// PurgeDeletedFavorites permanently deletes the favorites that were deleted before the restore window
+// and returns the number of purged favorites.
+func (s Service) PurgeDeletedFavorites(ctx context.Context) (int64, error) {
+	return s.controller.PurgeDeletedFavorites(ctx)
 
 }
 
+// RelayEvents publishes a batch of events of favorite mutations from the outbox with publisher
+// and returns the number of published events.
+func (s Service) RelayEvents(ctx context.Context, publisher EventPublisher) (int, error) {
+	return s.controller.RelayEvents(ctx, publisher)
+
+}
+
+// HandleFileEvent applies an event of the lifecycle of a file to its favorites
+// and returns the number of affected favorites.
+func (s Service) HandleFileEvent(ctx context.Context, event FileEvent) (int64, error) {
+	return s.controller.HandleFileEvent(ctx, event)
+
+}
+
+// ReconcileOrphans deletes or flags the favorites of files that don't exist anymore, as checked by checker,
+// and returns the report of the reconciliation.
+func (s Service) ReconcileOrphans(ctx context.Context, checker FileExistenceChecker, opts ReconcileOptions) (ReconcileReport, error) {
+	return s.controller.ReconcileOrphans(ctx, checker, opts)
+
+}
 
 // HealthCheck checks the health of the service, returns true if healthy, or false otherwise.
 func (s Service) HealthCheck(mongoClientPingTimeout time.Duration) bool {
@@ -106,6 +884,296 @@ func (s Service) HealthCheck(mongoClientPingTimeout time.Duration) bool {
 	}
 
 	return healthy
-	
+
+}
+
+// requestItem returns the item type and item ID of a request, where fileID is an alias of itemID
+// for files and an empty itemType is ItemTypeFile.
+func requestItem(itemType string, itemID string, fileID string) (string, string, error) {
+	if itemType == "" {
+		itemType = ItemTypeFile
+	}
+
+	if !itemTypes[itemType] {
+		return "", "", NewValidationError("itemType", fmt.Sprintf("unknown itemType %q", itemType))
+	}
+
+	if fileID != "" && itemType != ItemTypeFile {
+		return "", "", NewValidationError("fileID", "fileID may only be set for items of type file")
+	}
+
+	if fileID != "" && itemID != "" && fileID != itemID {
+		return "", "", NewValidationError("itemID", "itemID and fileID must be the same if both are set")
+	}
+
+	if itemID == "" {
+		itemID = fileID
+	}
+
+	if itemID == "" && itemType == ItemTypeFile {
+		return "", "", NewValidationError("fileID", "fileID is required")
+	}
+
+	if itemID == "" {
+		return "", "", NewValidationError("itemID", "itemID is required")
+	}
+
+	return itemType, itemID, nil
+
+}
+
+// requestItems returns the item type and item IDs of a batch request, where fileIDs is an alias of itemIDs
+// for files and an empty itemType is ItemTypeFile.
+func requestItems(itemType string, itemIDs []string, fileIDs []string) (string, []string, error) {
+	if itemType == "" {
+		itemType = ItemTypeFile
+	}
+
+	if !itemTypes[itemType] {
+		return "", nil, NewValidationError("itemType", fmt.Sprintf("unknown itemType %q", itemType))
+	}
+
+	if len(fileIDs) > 0 && itemType != ItemTypeFile {
+		return "", nil, NewValidationError("fileIDs", "fileIDs may only be set for items of type file")
+	}
+
+	if len(fileIDs) > 0 && len(itemIDs) > 0 {
+		return "", nil, NewValidationError("itemIDs", "only one of itemIDs and fileIDs may be set")
+	}
+
+	if len(fileIDs) > 0 {
+		return itemType, fileIDs, validateItemIDs("fileIDs", fileIDs)
+	}
+
+	if len(itemIDs) == 0 && itemType == ItemTypeFile {
+		return "", nil, NewValidationError("fileIDs", "fileIDs is required")
+	}
+
+	if len(itemIDs) == 0 {
+		return "", nil, NewValidationError("itemIDs", "itemIDs is required")
+	}
+
+	return itemType, itemIDs, validateItemIDs("itemIDs", itemIDs)
+
+}
+
+// neighborItemID returns the item ID of a neighbor of type itemType in a ReorderFavoritesRequest, where fileID
+// is an alias of itemID for files. field is the name of the itemID field of the neighbor.
+func neighborItemID(itemType string, itemID string, fileID string, field string) (string, error) {
+	if fileID != "" && itemType != ItemTypeFile {
+		return "", NewValidationError(field, "the fileID of a neighbor may only be set for items of type file")
+	}
+
+	if fileID != "" && itemID != "" && fileID != itemID {
+		return "", NewValidationError(field, "the itemID and fileID of a neighbor must be the same if both are set")
+	}
+
+	if itemID == "" {
+		itemID = fileID
+	}
+
+	return itemID, nil
+
+}
+
+// validateFileIDs validates the fileIDs of a batch request.
+func validateFileIDs(fileIDs []string) error {
+	return validateItemIDs("fileIDs", fileIDs)
+
+}
+
+// validateItemIDs validates the item IDs of field of a batch request.
+func validateItemIDs(field string, itemIDs []string) error {
+	if len(itemIDs) > MaxBatchSize {
+		return NewValidationError(field, fmt.Sprintf("%s must contain at most %d IDs", field, MaxBatchSize))
+	}
+
+	for _, itemID := range itemIDs {
+		if itemID == "" {
+			return NewValidationError(field, fmt.Sprintf("%s must not contain an empty ID", field))
+		}
+	}
+
+	return nil
+
+}
+
+// validateCollectionName validates the name of a collection.
+func validateCollectionName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return NewValidationError("name", "name is required")
+	}
+
+	if len(name) > MaxCollectionNameLength {
+		return NewValidationError("name", fmt.Sprintf("name must be at most %d characters long", MaxCollectionNameLength))
+	}
+
+	return nil
+
+}
+
+// validateCollectionFavorites validates req, a request of adding favorites to or removing them from
+// collections, and returns the item type and item IDs of its favorites.
+func validateCollectionFavorites(req *pb.CollectionFavoritesRequest) (string, []string, error) {
+	collectionIDs := req.GetCollectionIDs()
+
+	if req.GetUserID() == "" {
+		return "", nil, NewValidationError("userID", "userID is required")
+	}
+
+	itemType, itemIDs, err := requestItems(req.GetItemType(), req.GetItemIDs(), req.GetFileIDs())
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(collectionIDs) == 0 {
+		return "", nil, NewValidationError("collectionIDs", "collectionIDs is required")
+	}
+
+	if len(collectionIDs) > MaxCollectionsPerRequest {
+		return "", nil, NewValidationError("collectionIDs", fmt.Sprintf("collectionIDs must contain at most %d collectionIDs", MaxCollectionsPerRequest))
+	}
+
+	for _, collectionID := range collectionIDs {
+		if collectionID == "" {
+			return "", nil, NewValidationError("collectionIDs", "collectionIDs must not contain an empty collectionID")
+		}
+	}
+
+	return itemType, itemIDs, nil
+
+}
+
+// favoriteUpdate validates the fields of req in its update mask and returns them as a FavoriteUpdate.
+func favoriteUpdate(req *pb.UpdateFavoriteRequest) (FavoriteUpdate, error) {
+	update := FavoriteUpdate{}
+	updateMask := req.GetUpdateMask()
+	if len(updateMask) == 0 {
+		updateMask = []string{"tags", "note"}
+	}
+
+	for _, field := range updateMask {
+		switch field {
+		case "tags":
+			tags, err := normalizeTags(req.GetTags())
+			if err != nil {
+				return FavoriteUpdate{}, err
+			}
+
+			update.Tags = tags
+			update.SetTags = true
+		case "note":
+			if len(req.GetNote()) > MaxNoteLength {
+				return FavoriteUpdate{}, NewValidationError("note", fmt.Sprintf("note must be at most %d characters long", MaxNoteLength))
+			}
+
+			update.Note = req.GetNote()
+			update.SetNote = true
+		case "pinned":
+			update.Pinned = req.GetPinned()
+			update.SetPinned = true
+		default:
+			return FavoriteUpdate{}, NewValidationError("updateMask", fmt.Sprintf("unknown field %q", field))
+		}
+	}
+
+	return update, nil
+
+}
+
+// normalizeTags trims tags and removes duplicate tags, keeping their order, and validates them.
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			return nil, NewValidationError("tags", "tags must not contain an empty tag")
+		}
+
+		if len(tag) > MaxTagLength {
+			return nil, NewValidationError("tags", fmt.Sprintf("tags must be at most %d characters long", MaxTagLength))
+		}
+
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+
+	if len(normalized) > MaxTags {
+		return nil, NewValidationError("tags", fmt.Sprintf("tags must contain at most %d tags", MaxTags))
+	}
+
+	return normalized, nil
+
+}
+
+// marshalBatchResults marshals results into a batch response.
+func marshalBatchResults(results []BatchResult) (*pb.BatchFavoritesResponse, error) {
+	response := &pb.BatchFavoritesResponse{Results: make([]*pb.BatchFavoriteResult, 0, len(results))}
+	for _, result := range results {
+		var batchResult pb.BatchFavoriteResult
+		if err := result.MarshalProto(&batchResult); err != nil {
+			return nil, err
+		}
+
+		response.Results = append(response.Results, &batchResult)
+	}
+
+	return response, nil
+
+}
+
+// authorizedFavorites returns the favorites of userID out of favorites whose items userID may access,
+// in their order.
+func (s Service) authorizedFavorites(ctx context.Context, userID string, favorites []Favorite) ([]Favorite, error) {
+	// itemIDs holds the item IDs of favorites by their item type.
+	itemIDs := make(map[string][]string)
+	for _, favorite := range favorites {
+		itemIDs[favorite.GetItemType()] = append(itemIDs[favorite.GetItemType()], favorite.GetItemID())
+	}
+
+	// authorized holds whether each item may be accessed by the item type and the item ID.
+	authorized := make(map[string]map[string]bool, len(itemIDs))
+	for itemType, ids := range itemIDs {
+		itemsAuthorized, err := s.authorize(ctx, userID, itemType, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		authorized[itemType] = itemsAuthorized
+	}
+
+	authorizedFavorites := make([]Favorite, 0, len(favorites))
+	for _, favorite := range favorites {
+		if authorized[favorite.GetItemType()][favorite.GetItemID()] {
+			authorizedFavorites = append(authorizedFavorites, favorite)
+		}
+	}
+
+	return authorizedFavorites, nil
+
 }
 
+// authorize returns whether userID may access each of the items of itemType and itemIDs by their item ID.
+// An authorizer that doesn't return a result for each of the items fails with an internal error,
+// rather than leaving some of the items unchecked.
+func (s Service) authorize(ctx context.Context, userID string, itemType string, itemIDs []string) (map[string]bool, error) {
+	itemsAuthorized, err := s.authorizer.Authorize(ctx, userID, itemType, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(itemsAuthorized) != len(itemIDs) {
+		return nil, fmt.Errorf("authorizer returned %d results for %d %s items", len(itemsAuthorized), len(itemIDs), itemType)
+	}
+
+	authorized := make(map[string]bool, len(itemIDs))
+	for i, itemID := range itemIDs {
+		authorized[itemID] = itemsAuthorized[i]
+	}
+
+	return authorized, nil
+
+}
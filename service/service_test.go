@@ -0,0 +1,264 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/meateam/fav-service/proto"
+	"github.com/meateam/fav-service/service"
+	"github.com/meateam/fav-service/service/authorizer"
+	"github.com/meateam/fav-service/service/memory"
+	"github.com/sirupsen/logrus"
+)
+
+// newTestService returns a new service of a memory controller authorized by a,
+// which filters favorites if filterFavorites is set.
+func newTestService(t *testing.T, a service.Authorizer, filterFavorites bool) service.Service {
+	t.Helper()
+
+	controller, err := memory.NewMemoryController(time.Hour, 0, false)
+	if err != nil {
+		t.Fatalf("NewMemoryController() error = %v", err)
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+
+	return service.NewService(controller, a, filterFavorites, logger)
+
+}
+
+// mustCreateFavorites creates the favorites of fileIDs of userID with s and fails t if they can't be created.
+func mustCreateFavorites(t *testing.T, s service.Service, userID string, fileIDs ...string) {
+	t.Helper()
+
+	for _, fileID := range fileIDs {
+		if _, err := s.CreateFavorite(context.Background(), &pb.CreateFavoriteRequest{UserID: userID, FileID: fileID}); err != nil {
+			t.Fatalf("CreateFavorite(%s) error = %v", fileID, err)
+		}
+	}
+
+}
+
+// listedFileIDs returns the file IDs of the favorites of userID listed by s, joined by commas.
+func listedFileIDs(t *testing.T, s service.Service, userID string) string {
+	t.Helper()
+
+	response, err := s.GetAllFavorites(context.Background(), &pb.GetAllFavoritesRequest{UserID: userID, SortBy: pb.SortBy_FILE_ID})
+	if err != nil {
+		t.Fatalf("GetAllFavorites() error = %v", err)
+	}
+
+	return strings.Join(response.GetFavFileIDList(), ",")
+
+}
+
+// shortAuthorizer authorizes every item but the last of each request, for which it returns no result.
+type shortAuthorizer struct{}
+
+// Authorize returns true for each of itemIDs but the last.
+func (shortAuthorizer) Authorize(ctx context.Context, userID string, itemType string, itemIDs []string) ([]bool, error) {
+	authorized := make([]bool, len(itemIDs)-1)
+	for i := range authorized {
+		authorized[i] = true
+	}
+
+	return authorized, nil
+
+}
+
+func TestServiceCreateFavoriteAccessDenied(t *testing.T) {
+	a := authorizer.NewStaticAuthorizer()
+	a.Allow("user", service.ItemTypeFile, "file1")
+	s := newTestService(t, a, false)
+
+	mustCreateFavorites(t, s, "user", "file1")
+
+	_, err := s.CreateFavorite(context.Background(), &pb.CreateFavoriteRequest{UserID: "user", FileID: "file2"})
+
+	var serviceErr *service.Error
+	if !errors.As(err, &serviceErr) || serviceErr.Kind != service.KindPermissionDenied {
+		t.Fatalf("CreateFavorite() of a denied file error = %v, want a permission denied error", err)
+	}
+
+	if got := listedFileIDs(t, s, "user"); got != "file1" {
+		t.Errorf("GetAllFavorites() = %s, want file1", got)
+	}
+
+}
+
+func TestServiceCreateFavoritesAccessDenied(t *testing.T) {
+	a := authorizer.NewStaticAuthorizer()
+	a.Allow("user", service.ItemTypeFile, "file1", "file3")
+	s := newTestService(t, a, false)
+
+	response, err := s.CreateFavorites(context.Background(), &pb.CreateFavoritesRequest{UserID: "user", FileIDs: []string{"file1", "file2", "file3"}})
+	if err != nil {
+		t.Fatalf("CreateFavorites() error = %v", err)
+	}
+
+	want := []pb.BatchStatus{pb.BatchStatus_CREATED, pb.BatchStatus_PERMISSION_DENIED, pb.BatchStatus_CREATED}
+	results := response.GetResults()
+	if len(results) != len(want) {
+		t.Fatalf("CreateFavorites() results = %v, want %d results", results, len(want))
+	}
+
+	for i, result := range results {
+		if result.GetStatus() != want[i] {
+			t.Errorf("CreateFavorites() status of %s = %s, want %s", result.GetFileID(), result.GetStatus(), want[i])
+		}
+	}
+
+	if got := listedFileIDs(t, s, "user"); got != "file1,file3" {
+		t.Errorf("GetAllFavorites() = %s, want file1,file3", got)
+	}
+
+}
+
+func TestServiceFilterFavorites(t *testing.T) {
+	tests := []struct {
+		name            string
+		filterFavorites bool
+		want            string
+	}{
+		{name: "Filtered", filterFavorites: true, want: "file1,file3"},
+		{name: "Unfiltered", filterFavorites: false, want: "file1,file2,file3"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			a := authorizer.NewStaticAuthorizer()
+			a.Allow("user", service.ItemTypeFile, "file1", "file2", "file3")
+			s := newTestService(t, a, tt.filterFavorites)
+			mustCreateFavorites(t, s, "user", "file1", "file2", "file3")
+
+			// The user may no longer access file2 after favoriting it.
+			a.Deny("user", service.ItemTypeFile, "file2")
+			if got := listedFileIDs(t, s, "user"); got != tt.want {
+				t.Errorf("GetAllFavorites() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+}
+
+func TestServiceAuthorizerMissingResults(t *testing.T) {
+	s := newTestService(t, shortAuthorizer{}, false)
+	ctx := context.Background()
+
+	_, err := s.CreateFavorite(ctx, &pb.CreateFavoriteRequest{UserID: "user", FileID: "file1"})
+	if kind := service.ToError(err).Kind; err == nil || kind != service.KindInternal {
+		t.Errorf("CreateFavorite() error = %v, want an internal error", err)
+	}
+
+	_, err = s.CreateFavorites(ctx, &pb.CreateFavoritesRequest{UserID: "user", FileIDs: []string{"file1", "file2"}})
+	if kind := service.ToError(err).Kind; err == nil || kind != service.KindInternal {
+		t.Errorf("CreateFavorites() error = %v, want an internal error", err)
+	}
+
+	if got := listedFileIDs(t, s, "user"); got != "" {
+		t.Errorf("GetAllFavorites() = %s, want no favorites", got)
+	}
+
+}
+
+func TestServiceBatchItems(t *testing.T) {
+	a := authorizer.NewStaticAuthorizer()
+	a.Allow("user", service.ItemTypeFolder, "folder1", "folder2", "folder3")
+	a.Allow("user", service.ItemTypeFile, "folder1")
+	s := newTestService(t, a, false)
+	ctx := context.Background()
+
+	// A file with the same ID as a folder isn't affected by requests of folders.
+	mustCreateFavorites(t, s, "user", "folder1")
+
+	created, err := s.CreateFavorites(ctx, &pb.CreateFavoritesRequest{
+		UserID:   "user",
+		ItemType: service.ItemTypeFolder,
+		ItemIDs:  []string{"folder1", "folder2", "folder3"},
+	})
+	if err != nil {
+		t.Fatalf("CreateFavorites() error = %v", err)
+	}
+
+	for _, result := range created.GetResults() {
+		if result.GetStatus() != pb.BatchStatus_CREATED || result.GetFileID() != "" {
+			t.Errorf("CreateFavorites() result of %s = %v, want CREATED without a fileID", result.GetItemID(), result)
+		}
+	}
+
+	updated, err := s.UpdateFavorite(ctx, &pb.UpdateFavoriteRequest{
+		UserID:   "user",
+		ItemType: service.ItemTypeFolder,
+		ItemID:   "folder1",
+		Note:     "note",
+	})
+	if err != nil {
+		t.Fatalf("UpdateFavorite() error = %v", err)
+	}
+
+	if updated.GetItemType() != service.ItemTypeFolder || updated.GetNote() != "note" {
+		t.Errorf("UpdateFavorite() = %v, want the updated favorite of folder1", updated)
+	}
+
+	reordered, err := s.ReorderFavorites(ctx, &pb.ReorderFavoritesRequest{
+		UserID:         "user",
+		ItemType:       service.ItemTypeFolder,
+		ItemID:         "folder3",
+		PreviousItemID: "folder1",
+		NextItemID:     "folder2",
+	})
+	if err != nil {
+		t.Fatalf("ReorderFavorites() error = %v", err)
+	}
+
+	if reordered.GetItemID() != "folder3" || reordered.GetRank() == "" {
+		t.Errorf("ReorderFavorites() = %v, want the ranked favorite of folder3", reordered)
+	}
+
+	deleted, err := s.DeleteFavorites(ctx, &pb.DeleteFavoritesRequest{
+		UserID:   "user",
+		ItemType: service.ItemTypeFolder,
+		ItemIDs:  []string{"folder1", "folder2", "folder3"},
+	})
+	if err != nil {
+		t.Fatalf("DeleteFavorites() error = %v", err)
+	}
+
+	for _, result := range deleted.GetResults() {
+		if result.GetStatus() != pb.BatchStatus_DELETED {
+			t.Errorf("DeleteFavorites() status of %s = %s, want DELETED", result.GetItemID(), result.GetStatus())
+		}
+	}
+
+	if got := listedFileIDs(t, s, "user"); got != "folder1" {
+		t.Errorf("GetAllFavorites() = %s, want the file folder1", got)
+	}
+
+	_, err = s.DeleteFavorites(ctx, &pb.DeleteFavoritesRequest{UserID: "user", ItemType: service.ItemTypeFolder, FileIDs: []string{"folder1"}})
+	if kind := service.ToError(err).Kind; err == nil || kind != service.KindValidation {
+		t.Errorf("DeleteFavorites() of fileIDs of folders error = %v, want a validation error", err)
+	}
+
+}
+
+func TestServiceSetFavoriteQuotaLimit(t *testing.T) {
+	s := newTestService(t, authorizer.NewStaticAuthorizer(), false)
+	ctx := context.Background()
+
+	quota, err := s.SetFavoriteQuota(ctx, &pb.SetFavoriteQuotaRequest{UserID: "user", Limit: service.UnlimitedQuota})
+	if err != nil || quota.GetLimit() != service.UnlimitedQuota {
+		t.Errorf("SetFavoriteQuota() to UnlimitedQuota = %v, %v, want a limit of %d", quota, err, service.UnlimitedQuota)
+	}
+
+	_, err = s.SetFavoriteQuota(ctx, &pb.SetFavoriteQuotaRequest{UserID: "user", Limit: service.UnlimitedQuota - 1})
+	if kind := service.ToError(err).Kind; err == nil || kind != service.KindValidation {
+		t.Errorf("SetFavoriteQuota() to %d error = %v, want a validation error", service.UnlimitedQuota-1, err)
+	}
+
+}
@@ -2,13 +2,117 @@ package service
 
 import (
 	"context"
+	"errors"
 )
 
+var (
+	// ErrNotFound is returned by a Store when no favorite matches the given filter.
+	ErrNotFound = errors.New("favorite not found")
+
+	// ErrAlreadyExists is returned by a Store when creating a favorite that already exists.
+	ErrAlreadyExists = errors.New("favorite already exists")
+
+	// ErrInvalidPageToken is returned by a Store when the given page token is malformed.
+	ErrInvalidPageToken = errors.New("invalid page token")
+)
+
+// SortOrder is the order in which a Store lists favorites.
+type SortOrder int
+
+const (
+	// SortOldestFirst lists favorites by their creation time, oldest first.
+	SortOldestFirst SortOrder = iota
+
+	// SortNewestFirst lists favorites by their creation time, newest first.
+	SortNewestFirst
+
+	// SortByFileID lists favorites by their fileID in ascending order.
+	SortByFileID
+
+	// SortManual lists favorites in the order set by their user, the pinned favorites first
+	// and then by their rank in ascending order.
+	SortManual
+
+	// SortRecentlyDeleted lists deleted favorites by their deletion time, most recently deleted first.
+	SortRecentlyDeleted
+)
+
+// ListOptions are the options of listing favorites from a Store.
+type ListOptions struct {
+	// Sort is the order of the listed favorites, favorites created at the same time
+	// are ordered by their creation order.
+	Sort SortOrder
+
+	// PageSize is the maximum number of favorites to list, 0 lists all of them.
+	PageSize int
+
+	// PageToken is the next page token returned by the previous page, empty for the first page.
+	// A page token is only valid with the Sort it was returned for.
+	PageToken string
+}
+
 // Store is an interface for handling the storing of favorites.
+// The filter type is defined by each Store implementation.
 type Store interface {
-	GetAll(ctx context.Context, filter interface{}) ([]Favorite, error)
+	// GetAll returns the favorites matching filter, or an empty slice if there are none.
+	// The favorites are returned in opts.Sort order, if opts.PageSize is set then at most
+	// opts.PageSize favorites are returned along with the token of the next page,
+	// which is empty if there are no more favorites.
+	GetAll(ctx context.Context, filter interface{}, opts ListOptions) ([]Favorite, string, error)
+
+	// ForEach calls fn for each favorite matching filter in sort order, reading them one
+	// at a time. Iteration stops at the first error returned by fn or when ctx is done,
+	// and that error is returned.
+	ForEach(ctx context.Context, filter interface{}, sort SortOrder, fn func(Favorite) error) error
+
+	// Count returns the number of favorites matching filter.
+	Count(ctx context.Context, filter interface{}) (int64, error)
+
+	// Create stores favorite along with its tags, note, rank, pinned flag, expiration and deletion times
+	// and returns the stored favorite, setting its update time to the current time, and its creation time
+	// too unless favorite already has one. A favorite without a rank gets its DefaultRank.
+	// Only a single favorite may exist for each userID, item type and item ID.
 	Create(ctx context.Context, favorite Favorite) (Favorite, error)
+
+	// CreateMany stores favorites, creating as many of them as possible.
+	// Returns the error of each of favorites in their order, which is nil if it was created
+	// or ErrAlreadyExists if it already existed, or a non-nil error if none could be created.
+	CreateMany(ctx context.Context, favorites []Favorite) ([]error, error)
+
+	// Delete deletes a favorite matching filter and returns it,
+	// or ErrNotFound if there is none.
 	Delete(ctx context.Context, filter interface{}) (Favorite, error)
-	HealthCheck(ctx context.Context) (bool, error)
 
-}
\ No newline at end of file
+	// DeleteMany deletes all favorites matching filter and returns the number of deleted favorites.
+	DeleteMany(ctx context.Context, filter interface{}) (int64, error)
+
+	// Update applies update to a favorite matching filter, sets its update time and returns
+	// the updated favorite, or ErrNotFound if there is none.
+	Update(ctx context.Context, filter interface{}, update FavoriteUpdate) (Favorite, error)
+
+	// UpdateMany applies update to all favorites matching filter, sets their update time
+	// and returns the number of favorites matching filter.
+	UpdateMany(ctx context.Context, filter interface{}, update FavoriteUpdate) (int64, error)
+
+	// AddToCollections adds collectionIDs to the collections of all favorites matching filter,
+	// ignoring the collections that a favorite is already in, and setting their update time.
+	// Returns the number of favorites matching filter.
+	AddToCollections(ctx context.Context, filter interface{}, collectionIDs []string) (int64, error)
+
+	// RemoveFromCollections removes collectionIDs from the collections of all favorites matching filter,
+	// setting their update time. Returns the number of favorites matching filter.
+	RemoveFromCollections(ctx context.Context, filter interface{}, collectionIDs []string) (int64, error)
+
+	// SetHidden sets whether all favorites matching filter are hidden, setting their update time.
+	// Hidden favorites aren't active, like the favorites of files in the trash.
+	// Returns the number of favorites matching filter.
+	SetHidden(ctx context.Context, filter interface{}, hidden bool) (int64, error)
+
+	// SetOrphaned sets whether all favorites matching filter are hidden as orphaned, the favorites of files
+	// that were found missing, setting their update time. Unlike the favorites of files in the trash,
+	// orphaned favorites are shown again once their files are found. Setting whether a favorite is hidden
+	// with SetHidden makes it no longer orphaned. Returns the number of favorites matching filter.
+	SetOrphaned(ctx context.Context, filter interface{}, orphaned bool) (int64, error)
+
+	HealthCheck(ctx context.Context) (bool, error)
+}
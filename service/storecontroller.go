@@ -0,0 +1,1628 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// FavoriteFilter is a filter of favorites that a FilterBuilder builds into the filter type of its Store,
+// empty fields match any favorite.
+type FavoriteFilter struct {
+	UserID   string
+	ItemType string
+
+	// ItemIDs matches the favorites of any of ItemIDs, a non-nil empty ItemIDs matches no favorite.
+	ItemIDs []string
+
+	// CollectionID matches the favorites in the collection of CollectionID.
+	CollectionID string
+
+	// Tag matches the favorites tagged with Tag.
+	Tag string
+
+	// ActiveAt matches the favorites that haven't expired at ActiveAt, weren't deleted and aren't hidden.
+	ActiveAt time.Time
+
+	// IncludeHidden makes ActiveAt match the hidden favorites too.
+	IncludeHidden bool
+
+	// Hidden matches only the hidden favorites.
+	Hidden bool
+
+	// Orphaned matches only the favorites hidden as orphaned by SetOrphaned.
+	Orphaned bool
+
+	// InactiveAt matches the favorites that have expired at InactiveAt or were deleted, whether or not they're hidden.
+	InactiveAt time.Time
+
+	// ExpiredAt matches the favorites that have expired at ExpiredAt and weren't deleted, whether or not they're hidden.
+	ExpiredAt time.Time
+
+	// DeletedAfter matches the favorites deleted after DeletedAfter.
+	DeletedAfter time.Time
+
+	// DeletedBefore matches the favorites deleted at or before DeletedBefore.
+	DeletedBefore time.Time
+}
+
+// FilterBuilder is an interface for building the filters of a Store.
+type FilterBuilder interface {
+	// Filter returns the filter of the Store that matches the favorites matching filter.
+	Filter(filter FavoriteFilter) interface{}
+}
+
+// Transactor is an interface for running changes to the stores of a StoreController in a transaction.
+type Transactor interface {
+	// WithTransaction runs fn in a transaction with the context of the transaction, the changes made
+	// by fn are committed if it returns nil and are aborted otherwise. fn may run more than once.
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Watcher is an interface for watching the changes of favorites made by any instance of the service.
+type Watcher interface {
+	// WatchFavorites calls fn for each change of the favorites of userID, starting after the change of
+	// resumeToken if it's set, until ctx is done or fn returns an error.
+	WatchFavorites(ctx context.Context, userID string, resumeToken string, fn func(Change) error) error
+}
+
+// StoreControllerConfig is the configuration of a StoreController.
+type StoreControllerConfig struct {
+	Store           Store
+	AuditStore      AuditStore
+	CollectionStore CollectionStore
+	QuotaStore      QuotaStore
+
+	// Outbox holds the events of favorite mutations until they're published, nil if events aren't published.
+	// Without a Transactor, adding events to Outbox must not fail, since the mutations of the events are
+	// already stored by then.
+	Outbox Outbox
+
+	// Filters builds the filters of Store.
+	Filters FilterBuilder
+
+	// Transactor runs the mutations of favorites along with their events in transactions,
+	// nil if the stores don't support transactions.
+	Transactor Transactor
+
+	// Watcher watches the changes of favorites made by any instance of the service, if it's nil only
+	// the changes made by the StoreController are watched.
+	Watcher Watcher
+
+	// RestoreWindow is the duration after deleting a favorite during which it may be restored.
+	RestoreWindow time.Duration
+
+	// DefaultQuota is the maximum number of favorites of a user without a quota override, 0 if unlimited.
+	DefaultQuota int64
+}
+
+// StoreController is the favorite service business logic implementation using the stores of its config.
+type StoreController struct {
+	store           Store
+	auditStore      AuditStore
+	collectionStore CollectionStore
+	quotaStore      QuotaStore
+	outbox          Outbox
+	filters         FilterBuilder
+	transactor      Transactor
+	watcher         Watcher
+
+	// broadcaster broadcasts the changes of favorites made by this controller to their watchers,
+	// nil if the changes are watched by watcher.
+	broadcaster *Broadcaster
+
+	restoreWindow time.Duration
+	defaultQuota  int64
+}
+
+// StoreController must implement Controller.
+var _ Controller = StoreController{}
+
+// NewStoreController returns a new StoreController using the stores of config.
+func NewStoreController(config StoreControllerConfig) StoreController {
+	controller := StoreController{
+		store:           config.Store,
+		auditStore:      config.AuditStore,
+		collectionStore: config.CollectionStore,
+		quotaStore:      config.QuotaStore,
+		outbox:          config.Outbox,
+		filters:         config.Filters,
+		transactor:      config.Transactor,
+		watcher:         config.Watcher,
+		restoreWindow:   config.RestoreWindow,
+		defaultQuota:    config.DefaultQuota,
+	}
+
+	if controller.watcher == nil {
+		controller.broadcaster = NewBroadcaster()
+	}
+
+	return controller
+
+}
+
+// filter returns the filter of the store that matches the favorites matching filter.
+func (c StoreController) filter(filter FavoriteFilter) interface{} {
+	return c.filters.Filter(filter)
+
+}
+
+// itemFilter returns the filter of the favorite of the item of itemType and itemID of userID.
+func itemFilter(itemType string, itemID string, userID string) FavoriteFilter {
+	return FavoriteFilter{UserID: userID, ItemType: itemType, ItemIDs: []string{itemID}}
+
+}
+
+// GetAllFavorites gets all user favorite files by userID that match query,
+// returns the favorites and the next page token.
+func (c StoreController) GetAllFavorites(ctx context.Context, userID string, query FavoriteQuery, opts ListOptions) ([]Favorite, string, error) {
+	if query.CollectionID != "" {
+		if err := c.checkCollections(ctx, userID, []string{query.CollectionID}); err != nil {
+			return nil, "", err
+		}
+	}
+
+	filter := FavoriteFilter{
+		UserID:       userID,
+		ItemType:     query.ItemType,
+		CollectionID: query.CollectionID,
+		Tag:          query.Tag,
+		ActiveAt:     time.Now(),
+	}
+
+	favoriteFiles, nextPageToken, err := c.store.GetAll(ctx, c.filter(filter), opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return favoriteFiles, nextPageToken, nil
+
+}
+
+// ListFavorites calls fn for each user favorite by userID in sort order.
+func (c StoreController) ListFavorites(ctx context.Context, userID string, sort SortOrder, fn func(Favorite) error) error {
+	return c.store.ForEach(ctx, c.filter(FavoriteFilter{UserID: userID, ActiveAt: time.Now()}), sort, fn)
+
+}
+
+// CreateFavorite creates a Favorite of the item of itemType and itemID in store and returns the created favorite.
+// The favorite expires at expiresAt unless it's the zero time.
+// If the favorite already exists, returns the existing favorite if idempotent is set,
+// otherwise returns an AlreadyExists error. An expired or deleted favorite is replaced by the new one,
+// while a hidden favorite of a trashed file already exists, so it's shown again if the file is restored.
+// Returns a ResourceExhausted error if userID has reached its quota.
+func (c StoreController) CreateFavorite(ctx context.Context, itemType string, itemID string, userID string, expiresAt time.Time, idempotent bool) (Favorite, error) {
+	var createdFavorite Favorite
+	err := c.withEvents(ctx, func(ctx context.Context) ([]Event, error) {
+		err := c.createWithinQuota(ctx, userID, func() (map[string][]string, error) {
+			var err error
+			createdFavorite, err = c.createFavorite(ctx, &favoriteValue{itemID: itemID, itemType: itemType, userID: userID, expiresAt: expiresAt})
+			if err != nil {
+				return nil, err
+			}
+
+			return map[string][]string{itemType: {itemID}}, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return []Event{NewEvent(EventFavoriteCreated, createdFavorite, createdFavorite.GetCreatedAt())}, nil
+	})
+
+	if err == ErrAlreadyExists && idempotent {
+		return c.getExistingFavorite(ctx, itemType, itemID, userID)
+	}
+
+	if err == ErrAlreadyExists {
+		return nil, NewItemAlreadyExistsError(itemType, itemID, userID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed creating favorite: %w", err)
+	}
+
+	c.broadcast(ChangeCreated, createdFavorite)
+
+	return createdFavorite, nil
+
+}
+
+// createFavorite creates favorite in store and returns the created favorite,
+// replacing the existing favorite of its item if it has expired or was deleted.
+// The existing favorite is deleted before creating favorite rather than after failing to create it,
+// since a duplicate key error aborts the transaction that the favorite may be created in.
+func (c StoreController) createFavorite(ctx context.Context, favorite Favorite) (Favorite, error) {
+	if err := c.deleteInactiveFavorite(ctx, favorite.GetItemType(), favorite.GetItemID(), favorite.GetUserID()); err != nil {
+		return nil, err
+	}
+
+	return c.store.Create(ctx, favorite)
+
+}
+
+// createWithinQuota runs create, which creates favorites of userID and returns the item IDs of the created
+// favorites by their item type. The created favorites are deleted if they made userID exceed its quota.
+func (c StoreController) createWithinQuota(ctx context.Context, userID string, create func() (map[string][]string, error)) error {
+	return c.addWithinQuota(ctx, userID, func() (int64, func() error, error) {
+		created, err := create()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		return CountItemIDs(created), func() error { return c.deleteCreated(ctx, userID, created) }, nil
+	})
+
+}
+
+// addWithinQuota runs add, which adds favorites to the usage of userID by creating or restoring them,
+// and returns the number of added favorites and a function that undoes adding them. Adding the favorites
+// is undone if they made userID exceed its quota. Adds of favorites of the same user that ran concurrently
+// are detected by the version of the quota of the user, so they can't exceed the quota together,
+// and are undone and run again.
+func (c StoreController) addWithinQuota(ctx context.Context, userID string, add func() (int64, func() error, error)) error {
+	for attempt := 0; attempt < MaxQuotaAttempts; attempt++ {
+		quota, err := c.quotaStore.Get(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		limit := QuotaLimit(quota, c.defaultQuota)
+		added, undo, err := add()
+		if err != nil || limit == UnlimitedQuota || added == 0 {
+			return err
+		}
+
+		usage, err := c.usage(ctx, userID)
+		if err != nil {
+			return undoAdd(undo, err)
+		}
+
+		if usage > limit {
+			return undoAdd(undo, NewQuotaExceededError(userID, usage-added, limit))
+		}
+
+		incremented, err := c.quotaStore.IncrementVersion(ctx, userID, quota.Version)
+		if err != nil {
+			return undoAdd(undo, err)
+		}
+
+		if incremented {
+			return nil
+		}
+
+		if err := undo(); err != nil {
+			return err
+		}
+	}
+
+	return NewAbortedError(
+		"favorites of user were created concurrently, try again",
+		map[string]string{"userID": userID},
+	)
+
+}
+
+// undoAdd runs undo and returns err unless undo failed.
+func undoAdd(undo func() error, err error) error {
+	if undoErr := undo(); undoErr != nil {
+		return undoErr
+	}
+
+	return err
+
+}
+
+// usage returns the number of favorites of userID that count against its quota, which are the favorites
+// that haven't expired and weren't deleted, including the hidden favorites of files in the trash,
+// so restoring a file from the trash can't make its favoriters exceed their quota.
+func (c StoreController) usage(ctx context.Context, userID string) (int64, error) {
+	return c.store.Count(ctx, c.filter(FavoriteFilter{UserID: userID, ActiveAt: time.Now(), IncludeHidden: true}))
+
+}
+
+// deleteCreated deletes the favorites of userID of created, the item IDs of the favorites by their item type.
+func (c StoreController) deleteCreated(ctx context.Context, userID string, created map[string][]string) error {
+	for itemType, itemIDs := range created {
+		filter := FavoriteFilter{UserID: userID, ItemType: itemType, ItemIDs: itemIDs}
+		if _, err := c.store.DeleteMany(ctx, c.filter(filter)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+// GetFavoriteQuota returns the number of favorites of userID and the limit of favorites of userID.
+func (c StoreController) GetFavoriteQuota(ctx context.Context, userID string) (QuotaUsage, error) {
+	quota, err := c.quotaStore.Get(ctx, userID)
+	if err != nil {
+		return QuotaUsage{}, err
+	}
+
+	usage, err := c.usage(ctx, userID)
+	if err != nil {
+		return QuotaUsage{}, err
+	}
+
+	return QuotaUsage{UserID: userID, Usage: usage, Limit: QuotaLimit(quota, c.defaultQuota)}, nil
+
+}
+
+// SetFavoriteQuota overrides the limit of favorites of userID with limit, a limit of 0 restores the default limit
+// and UnlimitedQuota makes userID unlimited.
+// Returns the number of favorites of userID and its new limit.
+func (c StoreController) SetFavoriteQuota(ctx context.Context, userID string, limit int64) (QuotaUsage, error) {
+	if err := c.quotaStore.SetLimit(ctx, userID, limit); err != nil {
+		return QuotaUsage{}, fmt.Errorf("failed setting favorite quota: %w", err)
+	}
+
+	return c.GetFavoriteQuota(ctx, userID)
+
+}
+
+// deleteInactiveFavorite deletes the favorite of itemType, itemID and userID if it has expired or was deleted,
+// since expired and deleted favorites are kept until they're purged.
+func (c StoreController) deleteInactiveFavorite(ctx context.Context, itemType string, itemID string, userID string) error {
+	filter := itemFilter(itemType, itemID, userID)
+	filter.InactiveAt = time.Now()
+
+	_, err := c.store.Delete(ctx, c.filter(filter))
+	if err == ErrNotFound {
+		return nil
+	}
+
+	return err
+
+}
+
+// getExistingFavorite returns the existing favorite of itemType, itemID and userID after failing to create it,
+// which may be hidden.
+func (c StoreController) getExistingFavorite(ctx context.Context, itemType string, itemID string, userID string) (Favorite, error) {
+	filter := itemFilter(itemType, itemID, userID)
+	filter.ActiveAt = time.Now()
+	filter.IncludeHidden = true
+
+	favorites, _, err := c.store.GetAll(ctx, c.filter(filter), ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(favorites) == 0 {
+		return nil, NewAbortedError(
+			"favorite was deleted while being created, try again",
+			map[string]string{"itemType": itemType, "itemID": itemID, "userID": userID},
+		)
+	}
+
+	return favorites[0], nil
+
+}
+
+// DeleteFavorite deletes the favorite in store that matches userID, itemType and itemID by setting
+// its deletion time, so it may be restored within the restore window.
+// returns the deleted favorite / error
+func (c StoreController) DeleteFavorite(ctx context.Context, itemType string, itemID string, userID string) (Favorite, error) {
+	var favorite Favorite
+	err := c.withEvents(ctx, func(ctx context.Context) ([]Event, error) {
+		now := time.Now()
+		filter := itemFilter(itemType, itemID, userID)
+		filter.ActiveAt = now
+
+		var err error
+		favorite, err = c.store.Update(ctx, c.filter(filter), FavoriteUpdate{DeletedAt: now, SetDeletedAt: true})
+		if err != nil {
+			return nil, err
+		}
+
+		return []Event{NewEvent(EventFavoriteDeleted, favorite, now)}, nil
+	})
+	if err == ErrNotFound {
+		return nil, NewItemNotFoundError(itemType, itemID, userID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.broadcast(ChangeDeleted, favorite)
+
+	return favorite, nil
+
+}
+
+// RestoreFavorite restores the favorite of userID, itemType and itemID that was deleted within
+// the restore window and returns the restored favorite. Restoring a favorite is published as creating it.
+// Returns a ResourceExhausted error if userID has reached its quota.
+func (c StoreController) RestoreFavorite(ctx context.Context, itemType string, itemID string, userID string) (Favorite, error) {
+	var favorite Favorite
+	err := c.withEvents(ctx, func(ctx context.Context) ([]Event, error) {
+		now := time.Now()
+		err := c.addWithinQuota(ctx, userID, func() (int64, func() error, error) {
+			filter := itemFilter(itemType, itemID, userID)
+			filter.DeletedAfter = now.Add(-c.restoreWindow)
+
+			deleted, _, err := c.store.GetAll(ctx, c.filter(filter), ListOptions{})
+			if err != nil {
+				return 0, nil, err
+			}
+
+			if len(deleted) == 0 {
+				return 0, nil, ErrNotFound
+			}
+
+			favorite, err = c.store.Update(ctx, c.filter(filter), FavoriteUpdate{SetDeletedAt: true})
+			if err != nil {
+				return 0, nil, err
+			}
+
+			// Undoing the restore deletes the favorite again at its original deletion time.
+			undo := func() error {
+				update := FavoriteUpdate{DeletedAt: deleted[0].GetDeletedAt(), SetDeletedAt: true}
+				_, err := c.store.Update(ctx, c.filter(itemFilter(itemType, itemID, userID)), update)
+				return err
+			}
+
+			return 1, undo, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return []Event{NewEvent(EventFavoriteCreated, favorite, now)}, nil
+	})
+	if err == ErrNotFound {
+		return nil, NewDeletedItemNotFoundError(itemType, itemID, userID, c.restoreWindow)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed restoring favorite: %w", err)
+	}
+
+	c.broadcast(ChangeCreated, favorite)
+
+	return favorite, nil
+
+}
+
+// GetRecentlyDeletedFavorites gets the favorites of userID that were deleted within the restore window,
+// returns the favorites and the next page token.
+func (c StoreController) GetRecentlyDeletedFavorites(ctx context.Context, userID string, opts ListOptions) ([]Favorite, string, error) {
+	filter := FavoriteFilter{UserID: userID, DeletedAfter: time.Now().Add(-c.restoreWindow)}
+	favorites, nextPageToken, err := c.store.GetAll(ctx, c.filter(filter), opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return favorites, nextPageToken, nil
+
+}
+
+// ExpireFavorites deletes the favorites that have expired in batches of up to MaxBatchSize favorites, by setting
+// their deletion time like DeleteFavorite does, so they're purged once they were deleted before the restore window.
+// Returns the number of expired favorites, along with the number of favorites expired by the previous batches
+// and the error of a failed batch.
+func (c StoreController) ExpireFavorites(ctx context.Context) (int64, error) {
+	var expiredCount int64
+	for {
+		var batchCount int64
+		var expired []Favorite
+		err := c.withEvents(ctx, func(ctx context.Context) ([]Event, error) {
+			now := time.Now()
+			filter := FavoriteFilter{ExpiredAt: now}
+
+			var err error
+			expired, _, err = c.store.GetAll(ctx, c.filter(filter), ListOptions{PageSize: MaxBatchSize})
+			if err != nil {
+				return nil, err
+			}
+
+			batchCount, err = c.updateMany(ctx, expired, filter, FavoriteUpdate{DeletedAt: now, SetDeletedAt: true})
+			if err != nil {
+				return nil, err
+			}
+
+			events := make([]Event, 0, len(expired))
+			for _, favorite := range expired {
+				events = append(events, NewEvent(EventFavoriteDeleted, favorite, now))
+			}
+
+			return events, nil
+		})
+		if err != nil {
+			return expiredCount, fmt.Errorf("failed expiring favorites: %w", err)
+		}
+
+		c.broadcast(ChangeDeleted, expired...)
+
+		expiredCount += batchCount
+		if len(expired) < MaxBatchSize {
+			return expiredCount, nil
+		}
+	}
+
+}
+
+// PurgeDeletedFavorites permanently deletes the favorites that were deleted before the restore window
+// in batches of up to MaxBatchSize favorites, and returns the number of purged favorites.
+// Returns the number of favorites purged by the previous batches along with the error of a failed batch.
+func (c StoreController) PurgeDeletedFavorites(ctx context.Context) (int64, error) {
+	until := time.Now().Add(-c.restoreWindow)
+	var purgedCount int64
+	for {
+		var batchCount int64
+		var deleted []Favorite
+		err := c.withEvents(ctx, func(ctx context.Context) ([]Event, error) {
+			var err error
+			filter := FavoriteFilter{DeletedBefore: until}
+			deleted, _, err = c.store.GetAll(ctx, c.filter(filter), ListOptions{PageSize: MaxBatchSize})
+			if err != nil {
+				return nil, err
+			}
+
+			batchCount, err = c.deleteMany(ctx, deleted, filter)
+			if err != nil {
+				return nil, err
+			}
+
+			now := time.Now()
+			events := make([]Event, 0, len(deleted))
+			for _, favorite := range deleted {
+				events = append(events, NewEvent(EventFavoritePurged, favorite, now))
+			}
+
+			return events, nil
+		})
+		if err != nil {
+			return purgedCount, fmt.Errorf("failed purging deleted favorites: %w", err)
+		}
+
+		purgedCount += batchCount
+		if len(deleted) < MaxBatchSize {
+			return purgedCount, nil
+		}
+	}
+
+}
+
+// deleteMany permanently deletes favorites, if they still match filter,
+// and returns the number of deleted favorites.
+func (c StoreController) deleteMany(ctx context.Context, favorites []Favorite, filter FavoriteFilter) (int64, error) {
+	return forEachItemsFilter(favorites, filter, func(filter FavoriteFilter) (int64, error) {
+		return c.store.DeleteMany(ctx, c.filter(filter))
+	})
+
+}
+
+// updateMany updates favorites with update, if they still match filter,
+// and returns the number of updated favorites.
+func (c StoreController) updateMany(ctx context.Context, favorites []Favorite, filter FavoriteFilter, update FavoriteUpdate) (int64, error) {
+	return forEachItemsFilter(favorites, filter, func(filter FavoriteFilter) (int64, error) {
+		return c.store.UpdateMany(ctx, c.filter(filter), update)
+	})
+
+}
+
+// forEachItemsFilter calls fn with filter narrowed down to the favorites of each user and item type of favorites,
+// and returns the sum of the counts returned by fn.
+func forEachItemsFilter(favorites []Favorite, filter FavoriteFilter, fn func(filter FavoriteFilter) (int64, error)) (int64, error) {
+	// itemIDs holds the item IDs of favorites by their user and item type.
+	itemIDs := make(map[[2]string][]string)
+	for _, favorite := range favorites {
+		key := [2]string{favorite.GetUserID(), favorite.GetItemType()}
+		itemIDs[key] = append(itemIDs[key], favorite.GetItemID())
+	}
+
+	var total int64
+	for key, ids := range itemIDs {
+		filter.UserID, filter.ItemType, filter.ItemIDs = key[0], key[1], ids
+		count, err := fn(filter)
+		if err != nil {
+			return total, err
+		}
+
+		total += count
+	}
+
+	return total, nil
+
+}
+
+// IsFavorite returns whether each of fileIDs is a favorite of userID, in the order of fileIDs.
+func (c StoreController) IsFavorite(ctx context.Context, userID string, fileIDs []string) ([]bool, error) {
+	isFavorite := make([]bool, len(fileIDs))
+	if len(fileIDs) == 0 {
+		return isFavorite, nil
+	}
+
+	favorites, err := c.activeFavorites(ctx, userID, ItemTypeFile, fileIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	favoriteFileIDs := make(map[string]bool, len(favorites))
+	for _, favorite := range favorites {
+		favoriteFileIDs[favorite.GetFileID()] = true
+	}
+
+	for i, fileID := range fileIDs {
+		isFavorite[i] = favoriteFileIDs[fileID]
+	}
+
+	return isFavorite, nil
+
+}
+
+// activeFavorites returns the active favorites of userID of the items of itemType and itemIDs ordered by their item IDs.
+func (c StoreController) activeFavorites(ctx context.Context, userID string, itemType string, itemIDs []string) ([]Favorite, error) {
+	if len(itemIDs) == 0 {
+		return nil, nil
+	}
+
+	filter := FavoriteFilter{UserID: userID, ItemType: itemType, ItemIDs: itemIDs, ActiveAt: time.Now()}
+	favorites, _, err := c.store.GetAll(ctx, c.filter(filter), ListOptions{Sort: SortByFileID})
+
+	return favorites, err
+
+}
+
+// CreateFavorites creates the favorites of the items of itemType and itemIDs for userID and returns
+// the result of each item. None of the favorites are created if they would make userID exceed its quota.
+func (c StoreController) CreateFavorites(ctx context.Context, userID string, itemType string, itemIDs []string) ([]BatchResult, error) {
+	favorites := make([]Favorite, 0, len(itemIDs))
+	for _, itemID := range itemIDs {
+		favorites = append(favorites, &favoriteValue{itemID: itemID, itemType: itemType, userID: userID})
+	}
+
+	var errs []error
+	err := c.withEvents(ctx, func(ctx context.Context) ([]Event, error) {
+		err := c.createWithinQuota(ctx, userID, func() (map[string][]string, error) {
+			var err error
+			errs, err = c.createMany(ctx, userID, favorites)
+			if err != nil {
+				return nil, err
+			}
+
+			return CreatedItemIDs(favorites, errs), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return createdEvents(favorites, errs, time.Now()), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating favorites: %w", err)
+	}
+
+	if err := c.broadcastCreated(ctx, userID, favorites, errs); err != nil {
+		return nil, fmt.Errorf("failed creating favorites: %w", err)
+	}
+
+	results := make([]BatchResult, 0, len(itemIDs))
+	for i, itemID := range itemIDs {
+		result := BatchResult{ItemType: itemType, ItemID: itemID, Status: BatchCreated}
+		if errs[i] == ErrAlreadyExists {
+			result.Status = BatchAlreadyExists
+		} else if errs[i] != nil {
+			result.Status = BatchFailed
+			result.Err = errs[i]
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+
+}
+
+// createMany creates favorites of userID and returns the error of each favorite, favorites that
+// already exist but have expired or were deleted are replaced. The favorites that already exist are
+// looked up before creating the rest, since a duplicate key error aborts the transaction that the
+// favorites may be created in.
+func (c StoreController) createMany(ctx context.Context, userID string, favorites []Favorite) ([]error, error) {
+	errs := make([]error, len(favorites))
+	if len(favorites) == 0 {
+		return errs, nil
+	}
+
+	// itemIDs holds the item IDs of favorites by their item type.
+	itemIDs := make(map[string][]string)
+	for _, favorite := range favorites {
+		itemIDs[favorite.GetItemType()] = append(itemIDs[favorite.GetItemType()], favorite.GetItemID())
+	}
+
+	// existing holds the item IDs of the favorites that already exist by their item type.
+	existing := make(map[string]map[string]bool, len(itemIDs))
+	for itemType, ids := range itemIDs {
+		filter := FavoriteFilter{UserID: userID, ItemType: itemType, ItemIDs: ids}
+		inactiveFilter := filter
+		inactiveFilter.InactiveAt = time.Now()
+		if _, err := c.store.DeleteMany(ctx, c.filter(inactiveFilter)); err != nil {
+			return nil, err
+		}
+
+		existingFavorites, _, err := c.store.GetAll(ctx, c.filter(filter), ListOptions{Sort: SortByFileID})
+		if err != nil {
+			return nil, err
+		}
+
+		existing[itemType] = make(map[string]bool, len(existingFavorites))
+		for _, favorite := range existingFavorites {
+			existing[itemType][favorite.GetItemID()] = true
+		}
+	}
+
+	created := make([]Favorite, 0, len(favorites))
+	indexes := make([]int, 0, len(favorites))
+	for i, favorite := range favorites {
+		if existing[favorite.GetItemType()][favorite.GetItemID()] {
+			errs[i] = ErrAlreadyExists
+			continue
+		}
+
+		// An item that appears more than once is created only once.
+		existing[favorite.GetItemType()][favorite.GetItemID()] = true
+		created = append(created, favorite)
+		indexes = append(indexes, i)
+	}
+
+	if len(created) == 0 {
+		return errs, nil
+	}
+
+	createdErrs, err := c.store.CreateMany(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+
+	for j, i := range indexes {
+		errs[i] = createdErrs[j]
+	}
+
+	return errs, nil
+
+}
+
+// DeleteFavorites deletes the favorites of the items of itemType and itemIDs for userID by setting their
+// deletion time, so they may be restored within the restore window like a favorite deleted by DeleteFavorite,
+// and returns the result of each item.
+func (c StoreController) DeleteFavorites(ctx context.Context, userID string, itemType string, itemIDs []string) ([]BatchResult, error) {
+	var existing []Favorite
+	var existingItemIDs map[string]bool
+	var deleteErr error
+	err := c.withEvents(ctx, func(ctx context.Context) ([]Event, error) {
+		now := time.Now()
+		var err error
+		existing, err = c.activeFavorites(ctx, userID, itemType, itemIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		existingItemIDs = make(map[string]bool, len(existing))
+		toDelete := make([]string, 0, len(existing))
+		events := make([]Event, 0, len(existing))
+		for _, favorite := range existing {
+			existingItemIDs[favorite.GetItemID()] = true
+			toDelete = append(toDelete, favorite.GetItemID())
+			events = append(events, NewEvent(EventFavoriteDeleted, favorite, now))
+		}
+
+		deleteErr = nil
+		if len(toDelete) > 0 {
+			filter := FavoriteFilter{UserID: userID, ItemType: itemType, ItemIDs: toDelete, ActiveAt: now}
+			_, deleteErr = c.store.UpdateMany(ctx, c.filter(filter), FavoriteUpdate{DeletedAt: now, SetDeletedAt: true})
+		}
+
+		if deleteErr != nil {
+			return nil, nil
+		}
+
+		return events, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed deleting favorites: %w", err)
+	}
+
+	if deleteErr == nil {
+		c.broadcast(ChangeDeleted, existing...)
+	}
+
+	results := make([]BatchResult, 0, len(itemIDs))
+	for _, itemID := range itemIDs {
+		result := BatchResult{ItemType: itemType, ItemID: itemID, Status: BatchNotFound}
+		if existingItemIDs[itemID] && deleteErr != nil {
+			result.Status = BatchFailed
+			result.Err = deleteErr
+		} else if existingItemIDs[itemID] {
+			result.Status = BatchDeleted
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+
+}
+
+// RelayEvents publishes up to RelayBatchSize events of favorite mutations from the outbox
+// with publisher, and returns the number of published events.
+func (c StoreController) RelayEvents(ctx context.Context, publisher EventPublisher) (int, error) {
+	if c.outbox == nil {
+		return 0, nil
+	}
+
+	return RelayEvents(ctx, c.outbox, publisher, RelayBatchSize)
+
+}
+
+// WatchFavorites calls fn for each change of the favorites of userID, starting after the change of resumeToken
+// if it's set, until ctx is done or fn returns an error.
+// Without a Watcher only the changes made by c are watched, excluding changing the collections of favorites.
+func (c StoreController) WatchFavorites(ctx context.Context, userID string, resumeToken string, fn func(Change) error) error {
+	if c.broadcaster != nil {
+		return c.broadcaster.Watch(ctx, userID, resumeToken, fn)
+	}
+
+	return c.watcher.WatchFavorites(ctx, userID, resumeToken, fn)
+
+}
+
+// broadcast broadcasts the changes of changeType of favorites to their watchers
+// if they aren't watched by the watcher.
+func (c StoreController) broadcast(changeType string, favorites ...Favorite) {
+	if c.broadcaster != nil {
+		c.broadcaster.Publish(changeType, favorites...)
+	}
+
+}
+
+// broadcastCreated broadcasts the creation of the favorites of userID that were created, given errs,
+// the error of creating each of favorites, if they aren't watched by the watcher.
+// The created favorites are read back from the store.
+func (c StoreController) broadcastCreated(ctx context.Context, userID string, favorites []Favorite, errs []error) error {
+	if c.broadcaster == nil {
+		return nil
+	}
+
+	for itemType, itemIDs := range CreatedItemIDs(favorites, errs) {
+		filter := FavoriteFilter{UserID: userID, ItemType: itemType, ItemIDs: itemIDs}
+		created, _, err := c.store.GetAll(ctx, c.filter(filter), ListOptions{})
+		if err != nil {
+			return err
+		}
+
+		c.broadcaster.Publish(ChangeCreated, created...)
+	}
+
+	return nil
+
+}
+
+// withEvents runs fn, which mutates favorites and returns the events of the mutations, and adds the events
+// to the outbox if events are published. If the stores support transactions, fn and adding the events run
+// in a single transaction, so an event is stored if and only if its mutation is. Otherwise the events are
+// added after fn, so the Outbox of stores without transactions must not fail adding events.
+func (c StoreController) withEvents(ctx context.Context, fn func(ctx context.Context) ([]Event, error)) error {
+	if c.outbox == nil {
+		_, err := fn(ctx)
+		return err
+	}
+
+	return c.inTransaction(ctx, func(ctx context.Context) error {
+		events, err := fn(ctx)
+		if err != nil {
+			return err
+		}
+
+		return c.outbox.Add(ctx, events)
+	})
+
+}
+
+// inTransaction runs fn in a transaction if the stores support transactions, otherwise it just runs fn.
+func (c StoreController) inTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if c.transactor == nil {
+		return fn(ctx)
+	}
+
+	return c.transactor.WithTransaction(ctx, fn)
+
+}
+
+// addEvents adds events to the outbox if events are published.
+func (c StoreController) addEvents(ctx context.Context, events []Event) error {
+	if c.outbox == nil {
+		return nil
+	}
+
+	return c.outbox.Add(ctx, events)
+
+}
+
+// changedFavorites returns the active favorites matching filter at now, which are about to be changed,
+// if their changes are published or broadcast, otherwise it returns nil.
+func (c StoreController) changedFavorites(ctx context.Context, filter FavoriteFilter, now time.Time) ([]Favorite, error) {
+	if c.outbox == nil && c.broadcaster == nil {
+		return nil, nil
+	}
+
+	var favorites []Favorite
+	filter.ActiveAt = now
+	err := c.store.ForEach(ctx, c.filter(filter), SortOldestFirst, func(favorite Favorite) error {
+		favorites = append(favorites, favorite)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return favorites, nil
+
+}
+
+// favoriteEvents returns the events of eventType of favorites at now.
+func favoriteEvents(favorites []Favorite, eventType string, now time.Time) []Event {
+	events := make([]Event, 0, len(favorites))
+	for _, favorite := range favorites {
+		events = append(events, NewEvent(eventType, favorite, now))
+	}
+
+	return events
+
+}
+
+// createdEvents returns the events of creating favorites at now, given errs, the error of creating each of favorites.
+func createdEvents(favorites []Favorite, errs []error, now time.Time) []Event {
+	events := make([]Event, 0, len(favorites))
+	for i, favorite := range favorites {
+		if errs[i] == nil {
+			events = append(events, NewEvent(EventFavoriteCreated, favorite, now))
+		}
+	}
+
+	return events
+
+}
+
+// GetFileFavoriters gets the favorites of all users that favorited fileID,
+// returns the favorites and the next page token.
+func (c StoreController) GetFileFavoriters(ctx context.Context, fileID string, opts ListOptions) ([]Favorite, string, error) {
+	filter := FavoriteFilter{ItemType: ItemTypeFile, ItemIDs: []string{fileID}, ActiveAt: time.Now()}
+	favorites, nextPageToken, err := c.store.GetAll(ctx, c.filter(filter), opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return favorites, nextPageToken, nil
+
+}
+
+// CountFileFavorites returns the number of users that favorited fileID.
+func (c StoreController) CountFileFavorites(ctx context.Context, fileID string) (int64, error) {
+	filter := FavoriteFilter{ItemType: ItemTypeFile, ItemIDs: []string{fileID}, ActiveAt: time.Now()}
+	count, err := c.store.Count(ctx, c.filter(filter))
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+
+}
+
+// DeleteFavoritesByFiles deletes the favorites of fileIDs of all users
+// and returns the number of deleted favorites.
+func (c StoreController) DeleteFavoritesByFiles(ctx context.Context, fileIDs []string) (int64, error) {
+	if len(fileIDs) == 0 {
+		return 0, nil
+	}
+
+	var deletedCount int64
+	var deleted []Favorite
+	err := c.withEvents(ctx, func(ctx context.Context) ([]Event, error) {
+		now := time.Now()
+		filter := FavoriteFilter{ItemType: ItemTypeFile, ItemIDs: fileIDs}
+
+		var err error
+		deleted, err = c.changedFavorites(ctx, filter, now)
+		if err != nil {
+			return nil, err
+		}
+
+		deletedCount, err = c.store.DeleteMany(ctx, c.filter(filter))
+		return favoriteEvents(deleted, EventFavoriteDeleted, now), err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed deleting favorites of files: %w", err)
+	}
+
+	c.broadcast(ChangeDeleted, deleted...)
+
+	return deletedCount, nil
+
+}
+
+// hideFavoritesByFiles hides the favorites of fileIDs of all users and returns the number of hidden favorites.
+// Hiding an active favorite is published as deleting it.
+func (c StoreController) hideFavoritesByFiles(ctx context.Context, fileIDs []string) (int64, error) {
+	filter := FavoriteFilter{ItemType: ItemTypeFile, ItemIDs: fileIDs}
+
+	return c.hideFavorites(ctx, filter, func(ctx context.Context, filter interface{}) (int64, error) {
+		return c.store.SetHidden(ctx, filter, true)
+	})
+
+}
+
+// showFavoritesByFiles shows the hidden favorites of fileIDs of all users again and returns the number
+// of shown favorites. Showing an active favorite is published as creating it.
+func (c StoreController) showFavoritesByFiles(ctx context.Context, fileIDs []string) (int64, error) {
+	filter := FavoriteFilter{ItemType: ItemTypeFile, ItemIDs: fileIDs}
+
+	return c.showFavorites(ctx, filter, func(ctx context.Context, filter interface{}) (int64, error) {
+		return c.store.SetHidden(ctx, filter, false)
+	})
+
+}
+
+// flagFavoritesByFiles hides the active favorites of fileIDs of all users as orphaned and returns the number
+// of flagged favorites. Flagging a favorite is published as deleting it.
+func (c StoreController) flagFavoritesByFiles(ctx context.Context, fileIDs []string) (int64, error) {
+	filter := FavoriteFilter{ItemType: ItemTypeFile, ItemIDs: fileIDs, ActiveAt: time.Now()}
+
+	return c.hideFavorites(ctx, filter, func(ctx context.Context, filter interface{}) (int64, error) {
+		return c.store.SetOrphaned(ctx, filter, true)
+	})
+
+}
+
+// unflagFavoritesByFiles shows the favorites of fileIDs of all users that were flagged as orphaned again
+// and returns the number of shown favorites. Showing an active favorite is published as creating it.
+func (c StoreController) unflagFavoritesByFiles(ctx context.Context, fileIDs []string) (int64, error) {
+	filter := FavoriteFilter{ItemType: ItemTypeFile, ItemIDs: fileIDs, Orphaned: true}
+
+	return c.showFavorites(ctx, filter, func(ctx context.Context, filter interface{}) (int64, error) {
+		return c.store.SetOrphaned(ctx, filter, false)
+	})
+
+}
+
+// hideFavorites hides the favorites matching filter with hide and returns the number of hidden favorites.
+// Hiding an active favorite is published as deleting it.
+func (c StoreController) hideFavorites(
+	ctx context.Context,
+	filter FavoriteFilter,
+	hide func(ctx context.Context, filter interface{}) (int64, error),
+) (int64, error) {
+	var hiddenCount int64
+	var hidden []Favorite
+	err := c.withEvents(ctx, func(ctx context.Context) ([]Event, error) {
+		now := time.Now()
+
+		var err error
+		hidden, err = c.changedFavorites(ctx, filter, now)
+		if err != nil {
+			return nil, err
+		}
+
+		hiddenCount, err = hide(ctx, c.filter(filter))
+		return favoriteEvents(hidden, EventFavoriteDeleted, now), err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	c.broadcast(ChangeDeleted, hidden...)
+
+	return hiddenCount, nil
+
+}
+
+// showFavorites shows the hidden favorites matching filter again with show and returns the number
+// of shown favorites. Showing an active favorite is published as creating it.
+func (c StoreController) showFavorites(
+	ctx context.Context,
+	filter FavoriteFilter,
+	show func(ctx context.Context, filter interface{}) (int64, error),
+) (int64, error) {
+	var shownCount int64
+	var shown []Favorite
+	err := c.withEvents(ctx, func(ctx context.Context) ([]Event, error) {
+		now := time.Now()
+		hiddenFilter := filter
+		hiddenFilter.IncludeHidden = true
+		hiddenFilter.Hidden = true
+
+		var err error
+		shown, err = c.changedFavorites(ctx, hiddenFilter, now)
+		if err != nil {
+			return nil, err
+		}
+
+		shownCount, err = show(ctx, c.filter(filter))
+		return favoriteEvents(shown, EventFavoriteCreated, now), err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	c.broadcast(ChangeCreated, shown...)
+
+	return shownCount, nil
+
+}
+
+// HandleFileEvent applies event of the lifecycle of a file to the favorites of the file of all users
+// and returns the number of affected favorites. The favorites of a deleted file are deleted, the favorites
+// of a trashed file are hidden until it's restored, and other events are ignored. Hidden favorites count
+// against the quota of their users, so showing them again can't make a user exceed its quota.
+func (c StoreController) HandleFileEvent(ctx context.Context, event FileEvent) (int64, error) {
+	fileIDs := []string{event.FileID}
+	switch event.Type {
+	case FileEventDeleted:
+		return c.DeleteFavoritesByFiles(ctx, fileIDs)
+	case FileEventTrashed:
+		hiddenCount, err := c.hideFavoritesByFiles(ctx, fileIDs)
+		if err != nil {
+			return 0, fmt.Errorf("failed hiding favorites of trashed file: %w", err)
+		}
+
+		return hiddenCount, nil
+	case FileEventRestored:
+		restoredCount, err := c.showFavoritesByFiles(ctx, fileIDs)
+		if err != nil {
+			return 0, fmt.Errorf("failed restoring favorites of restored file: %w", err)
+		}
+
+		return restoredCount, nil
+	}
+
+	return 0, nil
+
+}
+
+// ReconcileOrphans shows the flagged favorites of files of all users whose files exist again, then walks
+// the active favorites of files of all users in batches, checks which of their files still exist with checker,
+// and deletes or flags the favorites of the files that don't, as set by opts. Returns the report of the
+// reconciliation, which is partial if it fails.
+func (c StoreController) ReconcileOrphans(ctx context.Context, checker FileExistenceChecker, opts ReconcileOptions) (ReconcileReport, error) {
+	reconcile := c.DeleteFavoritesByFiles
+	if opts.Action == ReconcileFlag {
+		reconcile = c.flagFavoritesByFiles
+	}
+
+	now := time.Now()
+	filter := c.filter(FavoriteFilter{ItemType: ItemTypeFile, ActiveAt: now})
+	flaggedFilter := c.filter(FavoriteFilter{ItemType: ItemTypeFile, ActiveAt: now, IncludeHidden: true, Hidden: true, Orphaned: true})
+	report, err := ReconcileOrphans(ctx, c.store, checker, opts, filter, reconcile, flaggedFilter, c.unflagFavoritesByFiles)
+	if err != nil {
+		return report, fmt.Errorf("failed reconciling orphaned favorites: %w", err)
+	}
+
+	return report, nil
+
+}
+
+// DeleteAllUserFavorites deletes all of the favorites of userID along with its collections and quota override,
+// records the deletion in an audit record and returns the number of deleted favorites. If the stores support
+// transactions, the deletions and the audit record are stored in a single transaction.
+func (c StoreController) DeleteAllUserFavorites(ctx context.Context, userID string, reason string, requestedBy string) (int64, error) {
+	var deletedCount int64
+	var deleted []Favorite
+	err := c.inTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		filter := FavoriteFilter{UserID: userID}
+
+		var err error
+		deleted, err = c.changedFavorites(ctx, filter, now)
+		if err != nil {
+			return err
+		}
+
+		deletedCount, err = c.store.DeleteMany(ctx, c.filter(filter))
+		if err != nil {
+			return fmt.Errorf("failed deleting favorites of user: %w", err)
+		}
+
+		if _, err := c.collectionStore.DeleteAll(ctx, userID); err != nil {
+			return fmt.Errorf("failed deleting collections of user: %w", err)
+		}
+
+		if err := c.quotaStore.Delete(ctx, userID); err != nil {
+			return fmt.Errorf("failed deleting quota of user: %w", err)
+		}
+
+		record := AuditRecord{
+			Action:       AuditActionDeleteAllUserFavorites,
+			UserID:       userID,
+			Reason:       reason,
+			RequestedBy:  requestedBy,
+			DeletedCount: deletedCount,
+			CreatedAt:    time.Now().UTC(),
+		}
+
+		if err := c.auditStore.Create(ctx, record); err != nil {
+			return fmt.Errorf("failed creating audit record: %w", err)
+		}
+
+		return c.addEvents(ctx, favoriteEvents(deleted, EventFavoriteDeleted, now))
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	c.broadcast(ChangeDeleted, deleted...)
+
+	return deletedCount, nil
+
+}
+
+// TransferFavorites re-keys the favorites of sourceUserID to targetUserID in batches, keeping
+// their creation times, tags, notes and order. Favorites that targetUserID already has are skipped, and the favorites
+// of sourceUserID are deleted unless keepSource is set. Returns the moved and skipped counts.
+// Returns a ResourceExhausted error if a batch would make targetUserID exceed its quota,
+// in which case the previous batches remain transferred.
+func (c StoreController) TransferFavorites(ctx context.Context, sourceUserID string, targetUserID string, keepSource bool) (int64, int64, error) {
+	var movedCount, skippedCount int64
+	batch := make([]Favorite, 0, MaxBatchSize)
+
+	// transferBatch transfers batch in a single transaction if the stores support transactions.
+	// The counts are added and batch is reset only after the transaction commits, since it may run more than once.
+	transferBatch := func() error {
+		var errs []error
+		var deleted []Favorite
+		err := c.withEvents(ctx, func(ctx context.Context) ([]Event, error) {
+			deleted = nil
+			err := c.createWithinQuota(ctx, targetUserID, func() (map[string][]string, error) {
+				var err error
+				errs, err = c.createMany(ctx, targetUserID, batch)
+				if err != nil {
+					return nil, err
+				}
+
+				return CreatedItemIDs(batch, errs), nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			now := time.Now()
+			events := createdEvents(batch, errs, now)
+
+			// itemIDs holds the item IDs of the batch by their item type.
+			itemIDs := make(map[string][]string)
+			for i, favorite := range batch {
+				if errs[i] != nil && errs[i] != ErrAlreadyExists {
+					return nil, errs[i]
+				}
+
+				itemIDs[favorite.GetItemType()] = append(itemIDs[favorite.GetItemType()], favorite.GetItemID())
+			}
+
+			if keepSource {
+				return events, nil
+			}
+
+			for itemType, ids := range itemIDs {
+				filter := FavoriteFilter{UserID: sourceUserID, ItemType: itemType, ItemIDs: ids}
+				deletedFavorites, err := c.changedFavorites(ctx, filter, now)
+				if err != nil {
+					return nil, err
+				}
+
+				if _, err := c.store.DeleteMany(ctx, c.filter(filter)); err != nil {
+					return nil, err
+				}
+
+				deleted = append(deleted, deletedFavorites...)
+			}
+
+			return append(events, favoriteEvents(deleted, EventFavoriteDeleted, now)...), nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := c.broadcastCreated(ctx, targetUserID, batch, errs); err != nil {
+			return err
+		}
+
+		c.broadcast(ChangeDeleted, deleted...)
+
+		for _, err := range errs {
+			if err == nil {
+				movedCount++
+			} else {
+				skippedCount++
+			}
+		}
+
+		batch = batch[:0]
+
+		return nil
+	}
+
+	filter := c.filter(FavoriteFilter{UserID: sourceUserID, ActiveAt: time.Now()})
+	err := c.store.ForEach(ctx, filter, SortOldestFirst, func(favorite Favorite) error {
+		batch = append(batch, &favoriteValue{
+			itemID:    favorite.GetItemID(),
+			itemType:  favorite.GetItemType(),
+			userID:    targetUserID,
+			createdAt: favorite.GetCreatedAt(),
+			tags:      favorite.GetTags(),
+			note:      favorite.GetNote(),
+			rank:      favorite.GetRank(),
+			pinned:    favorite.GetPinned(),
+			expiresAt: favorite.GetExpiresAt(),
+		})
+
+		if len(batch) < MaxBatchSize {
+			return nil
+		}
+
+		return transferBatch()
+	})
+
+	if err == nil && len(batch) > 0 {
+		err = transferBatch()
+	}
+
+	if err != nil {
+		return movedCount, skippedCount, fmt.Errorf("failed transferring favorites: %w", err)
+	}
+
+	return movedCount, skippedCount, nil
+
+}
+
+// CreateCollection creates a collection of userID named name and returns the created collection.
+func (c StoreController) CreateCollection(ctx context.Context, userID string, name string) (Collection, error) {
+	collection, err := c.collectionStore.Create(ctx, Collection{UserID: userID, Name: name})
+	if err == ErrCollectionAlreadyExists {
+		return Collection{}, NewCollectionAlreadyExistsError(name, userID)
+	}
+
+	if err != nil {
+		return Collection{}, fmt.Errorf("failed creating collection: %w", err)
+	}
+
+	return collection, nil
+
+}
+
+// GetCollections gets all of the collections of userID ordered by name.
+func (c StoreController) GetCollections(ctx context.Context, userID string) ([]Collection, error) {
+	return c.collectionStore.GetAll(ctx, userID)
+
+}
+
+// RenameCollection renames the collection of collectionID of userID to name and returns the renamed collection.
+func (c StoreController) RenameCollection(ctx context.Context, userID string, collectionID string, name string) (Collection, error) {
+	collection, err := c.collectionStore.Rename(ctx, userID, collectionID, name)
+	if err == ErrCollectionNotFound {
+		return Collection{}, NewCollectionNotFoundError(collectionID, userID)
+	}
+
+	if err == ErrCollectionAlreadyExists {
+		return Collection{}, NewCollectionAlreadyExistsError(name, userID)
+	}
+
+	if err != nil {
+		return Collection{}, fmt.Errorf("failed renaming collection: %w", err)
+	}
+
+	return collection, nil
+
+}
+
+// DeleteCollection deletes the collection of collectionID of userID and removes its favorites from it,
+// returns the deleted collection. If removing the favorites fails they keep the ID of the deleted
+// collection, which is harmless since it can't be listed or added to anymore.
+func (c StoreController) DeleteCollection(ctx context.Context, userID string, collectionID string) (Collection, error) {
+	collection, err := c.collectionStore.Delete(ctx, userID, collectionID)
+	if err == ErrCollectionNotFound {
+		return Collection{}, NewCollectionNotFoundError(collectionID, userID)
+	}
+
+	if err != nil {
+		return Collection{}, fmt.Errorf("failed deleting collection: %w", err)
+	}
+
+	filter := c.filter(FavoriteFilter{UserID: userID, CollectionID: collectionID})
+	if _, err := c.store.RemoveFromCollections(ctx, filter, []string{collectionID}); err != nil {
+		return Collection{}, fmt.Errorf("failed removing favorites from deleted collection: %w", err)
+	}
+
+	return collection, nil
+
+}
+
+// AddToCollections adds the favorites of userID of the items of itemType and itemIDs to the collections
+// of collectionIDs and returns the result of each item.
+func (c StoreController) AddToCollections(ctx context.Context, userID string, itemType string, itemIDs []string, collectionIDs []string) ([]BatchResult, error) {
+	if err := c.checkCollections(ctx, userID, collectionIDs); err != nil {
+		return nil, err
+	}
+
+	return c.updateCollections(ctx, userID, itemType, itemIDs, func(filter interface{}) (int64, error) {
+		return c.store.AddToCollections(ctx, filter, collectionIDs)
+	})
+
+}
+
+// RemoveFromCollections removes the favorites of userID of the items of itemType and itemIDs from the collections
+// of collectionIDs and returns the result of each item.
+func (c StoreController) RemoveFromCollections(ctx context.Context, userID string, itemType string, itemIDs []string, collectionIDs []string) ([]BatchResult, error) {
+	if err := c.checkCollections(ctx, userID, collectionIDs); err != nil {
+		return nil, err
+	}
+
+	return c.updateCollections(ctx, userID, itemType, itemIDs, func(filter interface{}) (int64, error) {
+		return c.store.RemoveFromCollections(ctx, filter, collectionIDs)
+	})
+
+}
+
+// checkCollections returns a NotFound error if any of collectionIDs isn't a collection of userID.
+func (c StoreController) checkCollections(ctx context.Context, userID string, collectionIDs []string) error {
+	for _, collectionID := range collectionIDs {
+		_, err := c.collectionStore.Get(ctx, userID, collectionID)
+		if err == ErrCollectionNotFound {
+			return NewCollectionNotFoundError(collectionID, userID)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+// updateCollections applies update to the filter of the existing favorites of userID of the items
+// of itemType and itemIDs and returns the result of each item.
+func (c StoreController) updateCollections(
+	ctx context.Context,
+	userID string,
+	itemType string,
+	itemIDs []string,
+	update func(filter interface{}) (int64, error),
+) ([]BatchResult, error) {
+	existing, err := c.activeFavorites(ctx, userID, itemType, itemIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed updating collections: %w", err)
+	}
+
+	existingItemIDs := make(map[string]bool, len(existing))
+	toUpdate := make([]string, 0, len(existing))
+	for _, favorite := range existing {
+		existingItemIDs[favorite.GetItemID()] = true
+		toUpdate = append(toUpdate, favorite.GetItemID())
+	}
+
+	var updateErr error
+	if len(toUpdate) > 0 {
+		filter := FavoriteFilter{UserID: userID, ItemType: itemType, ItemIDs: toUpdate, ActiveAt: time.Now()}
+		_, updateErr = update(c.filter(filter))
+	}
+
+	results := make([]BatchResult, 0, len(itemIDs))
+	for _, itemID := range itemIDs {
+		result := BatchResult{ItemType: itemType, ItemID: itemID, Status: BatchNotFound}
+		if existingItemIDs[itemID] && updateErr != nil {
+			result.Status = BatchFailed
+			result.Err = updateErr
+		} else if existingItemIDs[itemID] {
+			result.Status = BatchUpdated
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+
+}
+
+// UpdateFavorite updates the tags and note of the favorite of itemType, itemID and userID and returns the updated favorite.
+func (c StoreController) UpdateFavorite(ctx context.Context, itemType string, itemID string, userID string, update FavoriteUpdate) (Favorite, error) {
+	var favorite Favorite
+	err := c.inTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		filter := itemFilter(itemType, itemID, userID)
+		filter.ActiveAt = now
+
+		var err error
+		favorite, err = c.store.Update(ctx, c.filter(filter), update)
+		if err != nil {
+			return err
+		}
+
+		return c.addEvents(ctx, []Event{NewEvent(EventFavoriteUpdated, favorite, now)})
+	})
+	if err == ErrNotFound {
+		return nil, NewItemNotFoundError(itemType, itemID, userID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed updating favorite: %w", err)
+	}
+
+	c.broadcast(ChangeUpdated, favorite)
+
+	return favorite, nil
+
+}
+
+// ReorderFavorite moves the favorite of itemType and itemID of userID between the favorites of the items
+// of itemType and previousItemID and nextItemID in the SortManual order, by giving it a rank between their
+// ranks, and returns the moved favorite. An empty previousItemID or nextItemID moves it to the top or the bottom.
+func (c StoreController) ReorderFavorite(
+	ctx context.Context,
+	userID string,
+	itemType string,
+	itemID string,
+	previousItemID string,
+	nextItemID string,
+) (Favorite, error) {
+	itemIDs := []string{itemID}
+	for _, neighborItemID := range []string{previousItemID, nextItemID} {
+		if neighborItemID != "" {
+			itemIDs = append(itemIDs, neighborItemID)
+		}
+	}
+
+	var favorite Favorite
+	err := c.inTransaction(ctx, func(ctx context.Context) error {
+		favorites, err := c.activeFavorites(ctx, userID, itemType, itemIDs)
+		if err != nil {
+			return err
+		}
+
+		byItemID := make(map[string]Favorite, len(favorites))
+		for _, favorite := range favorites {
+			byItemID[favorite.GetItemID()] = favorite
+		}
+
+		for _, id := range itemIDs {
+			if byItemID[id] == nil {
+				return NewItemNotFoundError(itemType, id, userID)
+			}
+		}
+
+		rank, err := MoveRank(byItemID[itemID], byItemID[previousItemID], byItemID[nextItemID])
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		filter := itemFilter(itemType, itemID, userID)
+		filter.ActiveAt = now
+
+		favorite, err = c.store.Update(ctx, c.filter(filter), FavoriteUpdate{Rank: rank, SetRank: true})
+		if err != nil {
+			return err
+		}
+
+		return c.addEvents(ctx, []Event{NewEvent(EventFavoriteUpdated, favorite, now)})
+	})
+	if err == ErrNotFound {
+		return nil, NewItemNotFoundError(itemType, itemID, userID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed reordering favorite: %w", err)
+	}
+
+	c.broadcast(ChangeUpdated, favorite)
+
+	return favorite, nil
+
+}
+
+// HealthCheck runs store's healthcheck and returns true if healthy, otherwise returns false
+// and any error if occurred.
+func (c StoreController) HealthCheck(ctx context.Context) (bool, error) {
+	return c.store.HealthCheck(ctx)
+
+}
@@ -0,0 +1,139 @@
+package storetest
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/meateam/fav-service/proto"
+	"github.com/meateam/fav-service/service"
+)
+
+// favorite is a favorite that the tests pass to a Store to be created, the Store copies its fields.
+type favorite struct {
+	itemType  string
+	itemID    string
+	userID    string
+	expiresAt time.Time
+	deletedAt time.Time
+}
+
+// GetItemType returns f.itemType, or service.ItemTypeFile if f has no item type.
+func (f favorite) GetItemType() string {
+	if f.itemType == "" {
+		return service.ItemTypeFile
+	}
+
+	return f.itemType
+
+}
+
+// GetItemID returns f.itemID.
+func (f favorite) GetItemID() string {
+	return f.itemID
+
+}
+
+// GetFileID returns f.itemID if f is a favorite of a file, or an empty string otherwise.
+func (f favorite) GetFileID() string {
+	if f.GetItemType() != service.ItemTypeFile {
+		return ""
+	}
+
+	return f.itemID
+
+}
+
+// SetFileID sets f.itemID to fileID.
+func (f *favorite) SetFileID(fileID string) error {
+	if fileID == "" {
+		return fmt.Errorf("FileID is required")
+	}
+
+	f.itemID = fileID
+	f.itemType = service.ItemTypeFile
+	return nil
+
+}
+
+// GetUserID returns f.userID.
+func (f favorite) GetUserID() string {
+	return f.userID
+
+}
+
+// SetUserID sets f.userID to userID.
+func (f *favorite) SetUserID(userID string) error {
+	if userID == "" {
+		return fmt.Errorf("UserID is required")
+	}
+
+	f.userID = userID
+	return nil
+
+}
+
+// GetCreatedAt returns the zero time, since f wasn't stored yet.
+func (f favorite) GetCreatedAt() time.Time {
+	return time.Time{}
+
+}
+
+// GetUpdatedAt returns the zero time, since f wasn't stored yet.
+func (f favorite) GetUpdatedAt() time.Time {
+	return time.Time{}
+
+}
+
+// GetCollectionIDs returns nil, since f isn't in any collection.
+func (f favorite) GetCollectionIDs() []string {
+	return nil
+
+}
+
+// GetTags returns nil, since f has no tags.
+func (f favorite) GetTags() []string {
+	return nil
+
+}
+
+// GetNote returns an empty string, since f has no note.
+func (f favorite) GetNote() string {
+	return ""
+
+}
+
+// GetRank returns an empty string, so the store assigns f its default rank.
+func (f favorite) GetRank() string {
+	return ""
+
+}
+
+// GetPinned returns false, since f isn't pinned.
+func (f favorite) GetPinned() bool {
+	return false
+
+}
+
+// GetExpiresAt returns f.expiresAt.
+func (f favorite) GetExpiresAt() time.Time {
+	return f.expiresAt
+
+}
+
+// GetDeletedAt returns f.deletedAt.
+func (f favorite) GetDeletedAt() time.Time {
+	return f.deletedAt
+
+}
+
+// MarshalProto marshals f into a favorite.
+func (f favorite) MarshalProto(favorite *pb.FavoriteObject) error {
+	favorite.FileID = f.GetFileID()
+	favorite.ItemType = f.GetItemType()
+	favorite.ItemID = f.GetItemID()
+	favorite.UserID = f.GetUserID()
+	favorite.ExpiresAt = service.UnixMillis(f.GetExpiresAt())
+
+	return nil
+
+}
@@ -0,0 +1,1735 @@
+// Package storetest provides a conformance test suite for service.Store implementations.
+// Every Store implementation should run it to prove it behaves identically to the others.
+package storetest
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/meateam/fav-service/service"
+)
+
+// Harness adapts a service.Store implementation to the conformance tests.
+type Harness struct {
+	// NewStore returns a new empty store.
+	NewStore func(t *testing.T) service.Store
+
+	// Filter builds the filters of the stores returned by NewStore.
+	Filter service.FilterBuilder
+
+	// NewCollectionStore returns a new empty collection store.
+	NewCollectionStore func(t *testing.T) service.CollectionStore
+
+	// NewQuotaStore returns a new empty quota store.
+	NewQuotaStore func(t *testing.T) service.QuotaStore
+
+	// NewOutbox returns a new empty outbox.
+	NewOutbox func(t *testing.T) service.Outbox
+}
+
+// filter returns the filter of the store that matches the favorites matching filter.
+func (h Harness) filter(filter service.FavoriteFilter) interface{} {
+	return h.Filter.Filter(filter)
+
+}
+
+// Run runs the conformance tests against the stores created by h.
+func Run(t *testing.T, h Harness) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, h Harness)
+	}{
+		{name: "Create", test: testCreate},
+		{name: "CreateDuplicate", test: testCreateDuplicate},
+		{name: "CreateMissingFields", test: testCreateMissingFields},
+		{name: "GetAll", test: testGetAll},
+		{name: "GetAllEmpty", test: testGetAllEmpty},
+		{name: "GetAllPages", test: testGetAllPages},
+		{name: "GetAllPagesConcurrentWrites", test: testGetAllPagesConcurrentWrites},
+		{name: "GetAllInvalidPageToken", test: testGetAllInvalidPageToken},
+		{name: "GetAllSorted", test: testGetAllSorted},
+		{name: "GetAllSortedPages", test: testGetAllSortedPages},
+		{name: "GetAllManual", test: testGetAllManual},
+		{name: "Timestamps", test: testTimestamps},
+		{name: "CreatePreservesCreatedAt", test: testCreatePreservesCreatedAt},
+		{name: "ForEach", test: testForEach},
+		{name: "ForEachStop", test: testForEachStop},
+		{name: "ForEachCanceled", test: testForEachCanceled},
+		{name: "GetAllFileIDs", test: testGetAllFileIDs},
+		{name: "GetAllFile", test: testGetAllFile},
+		{name: "Count", test: testCount},
+		{name: "CreateMany", test: testCreateMany},
+		{name: "DeleteMany", test: testDeleteMany},
+		{name: "DeleteManyFiles", test: testDeleteManyFiles},
+		{name: "DeleteManyNoFileIDs", test: testDeleteManyNoFileIDs},
+		{name: "Delete", test: testDelete},
+		{name: "DeleteNotFound", test: testDeleteNotFound},
+		{name: "HealthCheck", test: testHealthCheck},
+		{name: "Update", test: testUpdate},
+		{name: "UpdateNotFound", test: testUpdateNotFound},
+		{name: "UpdateMany", test: testUpdateMany},
+		{name: "GetAllTag", test: testGetAllTag},
+		{name: "ItemTypes", test: testItemTypes},
+		{name: "DeleteItem", test: testDeleteItem},
+		{name: "ExpiresAt", test: testExpiresAt},
+		{name: "GetAllActive", test: testGetAllActive},
+		{name: "GetAllExpired", test: testGetAllExpired},
+		{name: "SoftDelete", test: testSoftDelete},
+		{name: "GetAllRecentlyDeleted", test: testGetAllRecentlyDeleted},
+		{name: "AddToCollections", test: testAddToCollections},
+		{name: "RemoveFromCollections", test: testRemoveFromCollections},
+		{name: "SetHidden", test: testSetHidden},
+		{name: "SetOrphaned", test: testSetOrphaned},
+		{name: "CollectionCreate", test: testCollectionCreate},
+		{name: "CollectionGetAll", test: testCollectionGetAll},
+		{name: "CollectionRename", test: testCollectionRename},
+		{name: "CollectionDelete", test: testCollectionDelete},
+		{name: "CollectionDeleteAll", test: testCollectionDeleteAll},
+		{name: "QuotaLimit", test: testQuotaLimit},
+		{name: "QuotaVersion", test: testQuotaVersion},
+		{name: "QuotaDelete", test: testQuotaDelete},
+		{name: "OutboxClaim", test: testOutboxClaim},
+		{name: "OutboxDelete", test: testOutboxDelete},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, h)
+		})
+	}
+
+}
+
+func testCreate(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	created, err := store.Create(context.Background(), &favorite{itemID: "file", userID: "user"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if created.GetFileID() != "file" || created.GetUserID() != "user" {
+		t.Errorf("Create() = (%s, %s), want (file, user)", created.GetFileID(), created.GetUserID())
+	}
+
+}
+
+func testCreateDuplicate(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	mustCreate(t, h, store, "file", "user")
+	if _, err := store.Create(ctx, &favorite{itemID: "file", userID: "user"}); !errors.Is(err, service.ErrAlreadyExists) {
+		t.Fatalf("Create() of an existing favorite error = %v, want %v", err, service.ErrAlreadyExists)
+	}
+
+	assertFileIDs(t, h, store, "user", "file")
+
+}
+
+func testCreateMissingFields(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	if _, err := store.Create(ctx, &favorite{itemID: "", userID: "user"}); err == nil {
+		t.Errorf("Create() with empty fileID error = nil, want error")
+	}
+
+	if _, err := store.Create(ctx, &favorite{itemID: "file", userID: ""}); err == nil {
+		t.Errorf("Create() with empty userID error = nil, want error")
+	}
+
+}
+
+func testGetAll(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+	mustCreate(t, h, store, "file1", "other")
+
+	assertFileIDs(t, h, store, "user", "file1", "file2")
+	assertFileIDs(t, h, store, "other", "file1")
+
+}
+
+func testGetAllEmpty(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	favorites, nextPageToken, err := store.GetAll(context.Background(), h.filter(service.FavoriteFilter{UserID: "user"}), service.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if len(favorites) != 0 || nextPageToken != "" {
+		t.Errorf("GetAll() returned %d favorites and token %q, want 0 and empty", len(favorites), nextPageToken)
+	}
+
+}
+
+func testGetAllPages(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	for _, fileID := range []string{"file1", "file2", "file3", "file4", "file5"} {
+		mustCreate(t, h, store, fileID, "user")
+	}
+
+	mustCreate(t, h, store, "file6", "other")
+
+	var pages [][]string
+	pageToken := ""
+	for {
+		favorites, nextPageToken, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user"}), service.ListOptions{PageSize: 2, PageToken: pageToken})
+		if err != nil {
+			t.Fatalf("GetAll() error = %v", err)
+		}
+
+		pages = append(pages, fileIDs(favorites))
+		if nextPageToken == "" {
+			break
+		}
+
+		if len(pages) > 3 {
+			t.Fatalf("GetAll() returned more than 3 pages: %v", pages)
+		}
+
+		pageToken = nextPageToken
+	}
+
+	want := [][]string{{"file1", "file2"}, {"file3", "file4"}, {"file5"}}
+	if len(pages) != len(want) {
+		t.Fatalf("GetAll() pages = %v, want %v", pages, want)
+	}
+
+	for i := range want {
+		if !equalStrings(pages[i], want[i]) {
+			t.Fatalf("GetAll() pages = %v, want %v", pages, want)
+		}
+	}
+
+}
+
+func testGetAllPagesConcurrentWrites(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	for _, fileID := range []string{"file1", "file2", "file3"} {
+		mustCreate(t, h, store, fileID, "user")
+	}
+
+	first, pageToken, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user"}), service.ListOptions{PageSize: 2})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if pageToken == "" {
+		t.Fatalf("GetAll() of the first page returned no next page token")
+	}
+
+	// Deleting a favorite of the previous page must not shift the next page.
+	if _, err := store.Delete(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file1"}})); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	mustCreate(t, h, store, "file4", "user")
+
+	second, nextPageToken, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user"}), service.ListOptions{PageSize: 2, PageToken: pageToken})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got, want := append(fileIDs(first), fileIDs(second)...), []string{"file1", "file2", "file3", "file4"}; !equalStrings(got, want) || nextPageToken != "" {
+		t.Errorf("GetAll() pages = %v with next page token %q, want %v and no token", got, nextPageToken, want)
+	}
+
+}
+
+func testGetAllInvalidPageToken(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	mustCreate(t, h, store, "file", "user")
+
+	_, _, err := store.GetAll(context.Background(), h.filter(service.FavoriteFilter{UserID: "user"}), service.ListOptions{PageSize: 1, PageToken: "not a token"})
+	if !errors.Is(err, service.ErrInvalidPageToken) {
+		t.Errorf("GetAll() with an invalid page token error = %v, want %v", err, service.ErrInvalidPageToken)
+	}
+
+}
+
+func testGetAllSorted(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	for _, fileID := range []string{"file3", "file1", "file2"} {
+		mustCreate(t, h, store, fileID, "user")
+	}
+
+	tests := []struct {
+		sort service.SortOrder
+		want []string
+	}{
+		{sort: service.SortOldestFirst, want: []string{"file3", "file1", "file2"}},
+		{sort: service.SortNewestFirst, want: []string{"file2", "file1", "file3"}},
+		{sort: service.SortByFileID, want: []string{"file1", "file2", "file3"}},
+	}
+
+	for _, tt := range tests {
+		favorites, _, err := store.GetAll(context.Background(), h.filter(service.FavoriteFilter{UserID: "user"}), service.ListOptions{Sort: tt.sort})
+		if err != nil {
+			t.Fatalf("GetAll(sort %d) error = %v", tt.sort, err)
+		}
+
+		if got := fileIDs(favorites); !equalStrings(got, tt.want) {
+			t.Errorf("GetAll(sort %d) = %v, want %v", tt.sort, got, tt.want)
+		}
+	}
+
+}
+
+func testGetAllManual(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	// New favorites get a default rank that orders them newest first.
+	mustCreate(t, h, store, "file1", "user")
+	time.Sleep(2 * time.Millisecond)
+	newest := mustCreate(t, h, store, "file2", "user")
+	if newest.GetRank() == "" || newest.GetPinned() {
+		t.Errorf("Create() = (%q, %v), want a default rank and not pinned", newest.GetRank(), newest.GetPinned())
+	}
+
+	assertManualOrder(t, h, store, "user", "file2", "file1")
+
+	mustCreate(t, h, store, "file3", "user")
+	mustCreate(t, h, store, "file4", "user")
+
+	updates := map[string]service.FavoriteUpdate{
+		"file1": {Rank: "b", SetRank: true},
+		"file2": {Rank: "a", SetRank: true},
+		"file3": {Rank: "z", SetRank: true, Pinned: true, SetPinned: true},
+		"file4": {Rank: "c", SetRank: true},
+	}
+
+	for fileID, update := range updates {
+		if _, err := store.Update(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{fileID}}), update); err != nil {
+			t.Fatalf("Update(%s) error = %v", fileID, err)
+		}
+	}
+
+	assertManualOrder(t, h, store, "user", "file3", "file2", "file1", "file4")
+
+}
+
+func testGetAllSortedPages(t *testing.T, h Harness) {
+	sortOrders := []service.SortOrder{service.SortOldestFirst, service.SortNewestFirst, service.SortByFileID, service.SortManual}
+	for _, sortOrder := range sortOrders {
+		store := h.NewStore(t)
+		ctx := context.Background()
+
+		for _, fileID := range []string{"file3", "file1", "file4", "file2"} {
+			mustCreate(t, h, store, fileID, "user")
+		}
+
+		all, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user"}), service.ListOptions{Sort: sortOrder})
+		if err != nil {
+			t.Fatalf("GetAll(sort %d) error = %v", sortOrder, err)
+		}
+
+		want := fileIDs(all)
+
+		first, pageToken, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user"}), service.ListOptions{Sort: sortOrder, PageSize: 2})
+		if err != nil {
+			t.Fatalf("GetAll(sort %d) error = %v", sortOrder, err)
+		}
+
+		// A page token of one sort order must not be accepted by another.
+		otherSort := (sortOrder + 1) % service.SortOrder(len(sortOrders))
+		_, _, err = store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user"}), service.ListOptions{Sort: otherSort, PageSize: 2, PageToken: pageToken})
+		if !errors.Is(err, service.ErrInvalidPageToken) {
+			t.Errorf("GetAll(sort %d) with a page token of sort %d error = %v, want %v", otherSort, sortOrder, err, service.ErrInvalidPageToken)
+		}
+
+		// Deleting the last favorite of a page must not affect the next page.
+		lastFileID := first[len(first)-1].GetFileID()
+		if _, err := store.Delete(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{lastFileID}})); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		second, nextPageToken, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user"}), service.ListOptions{Sort: sortOrder, PageSize: 2, PageToken: pageToken})
+		if err != nil {
+			t.Fatalf("GetAll(sort %d) error = %v", sortOrder, err)
+		}
+
+		if got := append(fileIDs(first), fileIDs(second)...); !equalStrings(got, want) || nextPageToken != "" {
+			t.Errorf("GetAll(sort %d) pages = %v with next page token %q, want %v and no token", sortOrder, got, nextPageToken, want)
+		}
+	}
+
+}
+
+func testTimestamps(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	before := time.Now().Add(-time.Second)
+
+	created, err := store.Create(context.Background(), &favorite{itemID: "file", userID: "user"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	after := time.Now().Add(time.Second)
+	if created.GetCreatedAt().Before(before) || created.GetCreatedAt().After(after) {
+		t.Errorf("Create() createdAt = %v, want between %v and %v", created.GetCreatedAt(), before, after)
+	}
+
+	if !created.GetUpdatedAt().Equal(created.GetCreatedAt()) {
+		t.Errorf("Create() updatedAt = %v, want createdAt %v", created.GetUpdatedAt(), created.GetCreatedAt())
+	}
+
+	favorites, _, err := store.GetAll(context.Background(), h.filter(service.FavoriteFilter{UserID: "user"}), service.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if len(favorites) != 1 || !favorites[0].GetCreatedAt().Equal(created.GetCreatedAt()) {
+		t.Errorf("GetAll() did not return the createdAt %v of the created favorite", created.GetCreatedAt())
+	}
+
+}
+
+func testCreatePreservesCreatedAt(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	created := mustCreate(t, h, store, "file", "user")
+	time.Sleep(5 * time.Millisecond)
+
+	deleted, err := store.Delete(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file"}}))
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	recreated, err := store.Create(ctx, deleted)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if !recreated.GetCreatedAt().Equal(created.GetCreatedAt()) {
+		t.Errorf("Create() of a favorite with createdAt %v set createdAt to %v", created.GetCreatedAt(), recreated.GetCreatedAt())
+	}
+
+	if !recreated.GetUpdatedAt().After(created.GetUpdatedAt()) {
+		t.Errorf("Create() updatedAt = %v, want after %v", recreated.GetUpdatedAt(), created.GetUpdatedAt())
+	}
+
+	recreatedMany, err := store.CreateMany(ctx, []service.Favorite{deleted})
+	if err != nil || !errors.Is(recreatedMany[0], service.ErrAlreadyExists) {
+		t.Fatalf("CreateMany() of an existing favorite = %v, %v, want %v", recreatedMany, err, service.ErrAlreadyExists)
+	}
+
+}
+
+func testForEach(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	for _, fileID := range []string{"file3", "file1", "file2"} {
+		mustCreate(t, h, store, fileID, "user")
+	}
+
+	mustCreate(t, h, store, "file4", "other")
+
+	for _, sortOrder := range []service.SortOrder{service.SortOldestFirst, service.SortNewestFirst, service.SortByFileID} {
+		all, _, err := store.GetAll(context.Background(), h.filter(service.FavoriteFilter{UserID: "user"}), service.ListOptions{Sort: sortOrder})
+		if err != nil {
+			t.Fatalf("GetAll(sort %d) error = %v", sortOrder, err)
+		}
+
+		var got []string
+		err = store.ForEach(context.Background(), h.filter(service.FavoriteFilter{UserID: "user"}), sortOrder, func(favorite service.Favorite) error {
+			got = append(got, favorite.GetFileID())
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("ForEach(sort %d) error = %v", sortOrder, err)
+		}
+
+		if want := fileIDs(all); !equalStrings(got, want) {
+			t.Errorf("ForEach(sort %d) = %v, want %v", sortOrder, got, want)
+		}
+	}
+
+}
+
+func testForEachStop(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	stop := errors.New("stop")
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+
+	calls := 0
+	err := store.ForEach(context.Background(), h.filter(service.FavoriteFilter{UserID: "user"}), service.SortOldestFirst, func(service.Favorite) error {
+		calls++
+		return stop
+	})
+
+	if err != stop || calls != 1 {
+		t.Errorf("ForEach() with fn returning an error = %v after %d calls, want %v after 1 call", err, calls, stop)
+	}
+
+}
+
+func testForEachCanceled(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	mustCreate(t, h, store, "file", "user")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := store.ForEach(ctx, h.filter(service.FavoriteFilter{UserID: "user"}), service.SortOldestFirst, func(service.Favorite) error {
+		return nil
+	})
+	if err == nil {
+		t.Errorf("ForEach() with a canceled context error = nil, want error")
+	}
+
+}
+
+func testGetAllFileIDs(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+	mustCreate(t, h, store, "file3", "user")
+	mustCreate(t, h, store, "file4", "other")
+
+	filter := h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file3", "file1", "file4", "missing"}})
+	favorites, _, err := store.GetAll(context.Background(), filter, service.ListOptions{Sort: service.SortByFileID})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got, want := fileIDs(favorites), []string{"file1", "file3"}; !equalStrings(got, want) {
+		t.Errorf("GetAll() of fileIDs = %v, want %v", got, want)
+	}
+
+}
+
+func testGetAllFile(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	mustCreate(t, h, store, "file", "user1")
+	mustCreate(t, h, store, "other", "user1")
+	mustCreate(t, h, store, "file", "user2")
+	mustCreate(t, h, store, "file", "user3")
+
+	var userIDs []string
+	pageToken := ""
+	for {
+		favorites, nextPageToken, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{ItemType: service.ItemTypeFile, ItemIDs: []string{"file"}}), service.ListOptions{PageSize: 2, PageToken: pageToken})
+		if err != nil {
+			t.Fatalf("GetAll() error = %v", err)
+		}
+
+		for _, favorite := range favorites {
+			userIDs = append(userIDs, favorite.GetUserID())
+		}
+
+		if nextPageToken == "" {
+			break
+		}
+
+		pageToken = nextPageToken
+	}
+
+	if want := []string{"user1", "user2", "user3"}; !equalStrings(userIDs, want) {
+		t.Errorf("GetAll() of file userIDs = %v, want %v", userIDs, want)
+	}
+
+}
+
+func testCount(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	mustCreate(t, h, store, "file", "user1")
+	mustCreate(t, h, store, "file", "user2")
+	mustCreate(t, h, store, "other", "user1")
+
+	tests := []struct {
+		name   string
+		filter interface{}
+		want   int64
+	}{
+		{name: "file", filter: h.filter(service.FavoriteFilter{ItemType: service.ItemTypeFile, ItemIDs: []string{"file"}}), want: 2},
+		{name: "missing file", filter: h.filter(service.FavoriteFilter{ItemType: service.ItemTypeFile, ItemIDs: []string{"missing"}}), want: 0},
+		{name: "user", filter: h.filter(service.FavoriteFilter{UserID: "user1"}), want: 2},
+	}
+
+	for _, tt := range tests {
+		count, err := store.Count(context.Background(), tt.filter)
+		if err != nil {
+			t.Fatalf("Count(%s) error = %v", tt.name, err)
+		}
+
+		if count != tt.want {
+			t.Errorf("Count(%s) = %d, want %d", tt.name, count, tt.want)
+		}
+	}
+
+}
+
+func testCreateMany(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	mustCreate(t, h, store, "existing", "user")
+
+	favorites := []service.Favorite{
+		&favorite{itemID: "file1", userID: "user"},
+		&favorite{itemID: "existing", userID: "user"},
+		&favorite{itemID: "file2", userID: "user"},
+		&favorite{itemID: "file1", userID: "user"},
+		&favorite{itemID: "", userID: "user"},
+	}
+
+	errs, err := store.CreateMany(context.Background(), favorites)
+	if err != nil {
+		t.Fatalf("CreateMany() error = %v", err)
+	}
+
+	if len(errs) != len(favorites) {
+		t.Fatalf("CreateMany() returned %d errors, want %d", len(errs), len(favorites))
+	}
+
+	if errs[0] != nil || errs[2] != nil {
+		t.Errorf("CreateMany() errors of new favorites = %v, %v, want nil", errs[0], errs[2])
+	}
+
+	if !errors.Is(errs[1], service.ErrAlreadyExists) || !errors.Is(errs[3], service.ErrAlreadyExists) {
+		t.Errorf("CreateMany() errors of existing favorites = %v, %v, want %v", errs[1], errs[3], service.ErrAlreadyExists)
+	}
+
+	if errs[4] == nil || errors.Is(errs[4], service.ErrAlreadyExists) {
+		t.Errorf("CreateMany() error of a favorite without fileID = %v, want a validation error", errs[4])
+	}
+
+	assertFileIDs(t, h, store, "user", "existing", "file1", "file2")
+
+}
+
+func testDeleteMany(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+	mustCreate(t, h, store, "file3", "user")
+	mustCreate(t, h, store, "file1", "other")
+
+	deleted, err := store.DeleteMany(context.Background(), h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file1", "file3", "missing"}}))
+	if err != nil {
+		t.Fatalf("DeleteMany() error = %v", err)
+	}
+
+	if deleted != 2 {
+		t.Errorf("DeleteMany() = %d, want 2", deleted)
+	}
+
+	assertFileIDs(t, h, store, "user", "file2")
+	assertFileIDs(t, h, store, "other", "file1")
+
+}
+
+func testDeleteManyFiles(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	mustCreate(t, h, store, "file1", "user1")
+	mustCreate(t, h, store, "file1", "user2")
+	mustCreate(t, h, store, "file2", "user1")
+	mustCreate(t, h, store, "file3", "user2")
+
+	deleted, err := store.DeleteMany(context.Background(), h.filter(service.FavoriteFilter{ItemType: service.ItemTypeFile, ItemIDs: []string{"file1", "file3", "missing"}}))
+	if err != nil {
+		t.Fatalf("DeleteMany() error = %v", err)
+	}
+
+	if deleted != 3 {
+		t.Errorf("DeleteMany() = %d, want 3", deleted)
+	}
+
+	assertFileIDs(t, h, store, "user1", "file2")
+	assertFileIDs(t, h, store, "user2")
+
+}
+
+func testDeleteManyNoFileIDs(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+
+	deleted, err := store.DeleteMany(context.Background(), h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{}}))
+	if err != nil {
+		t.Fatalf("DeleteMany() error = %v", err)
+	}
+
+	if deleted != 0 {
+		t.Errorf("DeleteMany() = %d, want 0", deleted)
+	}
+
+	assertFileIDs(t, h, store, "user", "file1", "file2")
+
+}
+
+func testDelete(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+
+	deleted, err := store.Delete(context.Background(), h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file1"}}))
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if deleted.GetFileID() != "file1" || deleted.GetUserID() != "user" {
+		t.Errorf("Delete() = (%s, %s), want (file1, user)", deleted.GetFileID(), deleted.GetUserID())
+	}
+
+	assertFileIDs(t, h, store, "user", "file2")
+
+}
+
+func testDeleteNotFound(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	mustCreate(t, h, store, "file", "other")
+
+	_, err := store.Delete(context.Background(), h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file"}}))
+	if !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Delete() of a missing favorite error = %v, want %v", err, service.ErrNotFound)
+	}
+
+	assertFileIDs(t, h, store, "other", "file")
+
+}
+
+func testHealthCheck(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	healthy, err := store.HealthCheck(context.Background())
+	if err != nil || !healthy {
+		t.Errorf("HealthCheck() = %v, %v, want true, nil", healthy, err)
+	}
+
+}
+
+func testUpdate(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	created := mustCreate(t, h, store, "file", "user")
+
+	updated, err := store.Update(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file"}}), service.FavoriteUpdate{
+		Tags:    []string{"reports", "q3"},
+		SetTags: true,
+		Note:    "quarterly numbers",
+		SetNote: true,
+	})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	if !equalStrings(updated.GetTags(), []string{"reports", "q3"}) || updated.GetNote() != "quarterly numbers" {
+		t.Errorf("Update() = (%v, %q), want ([reports q3], quarterly numbers)", updated.GetTags(), updated.GetNote())
+	}
+
+	if !updated.GetCreatedAt().Equal(created.GetCreatedAt()) || updated.GetUpdatedAt().Before(created.GetUpdatedAt()) {
+		t.Errorf("Update() times = (%v, %v), want the creation time kept and the update time advanced",
+			updated.GetCreatedAt(), updated.GetUpdatedAt())
+	}
+
+	// Fields that aren't set are left unchanged, and set empty fields are cleared.
+	updated, err = store.Update(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file"}}), service.FavoriteUpdate{SetNote: true})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	if !equalStrings(updated.GetTags(), []string{"reports", "q3"}) || updated.GetNote() != "" {
+		t.Errorf("Update() of the note = (%v, %q), want ([reports q3], \"\")", updated.GetTags(), updated.GetNote())
+	}
+
+	favorites, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file"}}), service.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if len(favorites) != 1 || !equalStrings(favorites[0].GetTags(), []string{"reports", "q3"}) {
+		t.Errorf("GetAll() after Update() = %v, want the updated favorite", favorites)
+	}
+
+}
+
+func testUpdateNotFound(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+
+	mustCreate(t, h, store, "file", "other")
+
+	update := service.FavoriteUpdate{Note: "note", SetNote: true}
+	if _, err := store.Update(context.Background(), h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file"}}), update); !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Update() of a missing favorite error = %v, want %v", err, service.ErrNotFound)
+	}
+
+}
+
+func testUpdateMany(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+	mustCreate(t, h, store, "file3", "user")
+	mustCreate(t, h, store, "file1", "other")
+
+	deletedAt := time.Now().UTC().Truncate(time.Millisecond)
+	update := service.FavoriteUpdate{DeletedAt: deletedAt, SetDeletedAt: true}
+	updated, err := store.UpdateMany(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file1", "file3", "missing"}}), update)
+	if err != nil {
+		t.Fatalf("UpdateMany() error = %v", err)
+	}
+
+	if updated != 2 {
+		t.Errorf("UpdateMany() = %d, want 2", updated)
+	}
+
+	active, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", ActiveAt: time.Now()}), service.ListOptions{Sort: service.SortByFileID})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got := fileIDs(active); !equalStrings(got, []string{"file2"}) {
+		t.Errorf("GetAll() of active favorites fileIDs = %v, want [file2]", got)
+	}
+
+	deleted, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", DeletedAfter: deletedAt.Add(-time.Minute)}), service.ListOptions{Sort: service.SortByFileID})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got := fileIDs(deleted); !equalStrings(got, []string{"file1", "file3"}) {
+		t.Errorf("GetAll() of deleted favorites fileIDs = %v, want [file1 file3]", got)
+	}
+
+	for _, favorite := range deleted {
+		if !favorite.GetDeletedAt().Equal(deletedAt) {
+			t.Errorf("UpdateMany() deletedAt of %s = %v, want %v", favorite.GetFileID(), favorite.GetDeletedAt(), deletedAt)
+		}
+	}
+
+	assertFileIDs(t, h, store, "other", "file1")
+
+}
+
+func testGetAllTag(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+	mustCreate(t, h, store, "file3", "user")
+	mustCreate(t, h, store, "file1", "other")
+
+	for fileID, tags := range map[string][]string{"file1": {"a", "b"}, "file2": {"b"}} {
+		if _, err := store.Update(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{fileID}}), service.FavoriteUpdate{Tags: tags, SetTags: true}); err != nil {
+			t.Fatalf("Update(%s) error = %v", fileID, err)
+		}
+	}
+
+	if _, err := store.Update(ctx, h.filter(service.FavoriteFilter{UserID: "other", ItemType: service.ItemTypeFile, ItemIDs: []string{"file1"}}), service.FavoriteUpdate{Tags: []string{"b"}, SetTags: true}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	for tag, want := range map[string][]string{"a": {"file1"}, "b": {"file1", "file2"}, "c": {}} {
+		favorites, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", Tag: tag}), service.ListOptions{})
+		if err != nil {
+			t.Fatalf("GetAll(%s) error = %v", tag, err)
+		}
+
+		got := fileIDs(favorites)
+		sort.Strings(got)
+		if !equalStrings(got, want) {
+			t.Errorf("GetAll(%s) fileIDs = %v, want %v", tag, got, want)
+		}
+	}
+
+}
+
+func testItemTypes(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	// The same item ID may be favorited once for each item type.
+	mustCreate(t, h, store, "item", "user")
+	folder, err := store.Create(ctx, &favorite{itemType: service.ItemTypeFolder, itemID: "item", userID: "user"})
+	if err != nil {
+		t.Fatalf("Create() of a folder error = %v", err)
+	}
+
+	if folder.GetItemType() != service.ItemTypeFolder || folder.GetItemID() != "item" || folder.GetFileID() != "" {
+		t.Errorf("Create() of a folder = (%s, %s, %q), want (folder, item, \"\")",
+			folder.GetItemType(), folder.GetItemID(), folder.GetFileID())
+	}
+
+	_, err = store.Create(ctx, &favorite{itemType: service.ItemTypeFolder, itemID: "item", userID: "user"})
+	if !errors.Is(err, service.ErrAlreadyExists) {
+		t.Errorf("Create() of an existing folder favorite error = %v, want %v", err, service.ErrAlreadyExists)
+	}
+
+	mustCreate(t, h, store, "file", "user")
+	if _, err := store.Create(ctx, &favorite{itemType: service.ItemTypePerson, itemID: "person", userID: "user"}); err != nil {
+		t.Fatalf("Create() of a person error = %v", err)
+	}
+
+	for itemType, want := range map[string][]string{
+		service.ItemTypeFile:        {"file", "item"},
+		service.ItemTypeFolder:      {"item"},
+		service.ItemTypePerson:      {"person"},
+		service.ItemTypeSharedDrive: {},
+	} {
+		favorites, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: itemType}), service.ListOptions{})
+		if err != nil {
+			t.Fatalf("GetAll(%s) error = %v", itemType, err)
+		}
+
+		got := make([]string, 0, len(favorites))
+		for _, favorite := range favorites {
+			if favorite.GetItemType() != itemType {
+				t.Errorf("GetAll(%s) returned a favorite of item type %s", itemType, favorite.GetItemType())
+			}
+
+			got = append(got, favorite.GetItemID())
+		}
+
+		sort.Strings(got)
+		if !equalStrings(got, want) {
+			t.Errorf("GetAll(%s) itemIDs = %v, want %v", itemType, got, want)
+		}
+	}
+
+}
+
+func testDeleteItem(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	mustCreate(t, h, store, "item", "user")
+	if _, err := store.Create(ctx, &favorite{itemType: service.ItemTypeFolder, itemID: "item", userID: "user"}); err != nil {
+		t.Fatalf("Create() of a folder error = %v", err)
+	}
+
+	deleted, err := store.Delete(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFolder, ItemIDs: []string{"item"}}))
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if deleted.GetItemType() != service.ItemTypeFolder || deleted.GetItemID() != "item" {
+		t.Errorf("Delete() = (%s, %s), want (folder, item)", deleted.GetItemType(), deleted.GetItemID())
+	}
+
+	_, err = store.Delete(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFolder, ItemIDs: []string{"item"}}))
+	if !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Delete() of a deleted folder favorite error = %v, want %v", err, service.ErrNotFound)
+	}
+
+	// The favorite of the file with the same ID is kept.
+	assertFileIDs(t, h, store, "user", "item")
+
+}
+
+func testExpiresAt(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Millisecond)
+	created, err := store.Create(ctx, &favorite{itemID: "file", userID: "user", expiresAt: expiresAt})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if !created.GetExpiresAt().Equal(expiresAt) {
+		t.Errorf("Create() expiresAt = %v, want %v", created.GetExpiresAt(), expiresAt)
+	}
+
+	if created := mustCreate(t, h, store, "other", "user"); !created.GetExpiresAt().IsZero() {
+		t.Errorf("Create() of a favorite that never expires expiresAt = %v, want the zero time", created.GetExpiresAt())
+	}
+
+}
+
+func testGetAllActive(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	mustCreate(t, h, store, "file1", "user")
+	for fileID, expiresAt := range map[string]time.Time{"file2": now.Add(-time.Minute), "file3": now.Add(time.Hour)} {
+		if _, err := store.Create(ctx, &favorite{itemID: fileID, userID: "user", expiresAt: expiresAt}); err != nil {
+			t.Fatalf("Create(%s) error = %v", fileID, err)
+		}
+	}
+
+	favorites, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", ActiveAt: now}), service.ListOptions{Sort: service.SortByFileID})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got := fileIDs(favorites); !equalStrings(got, []string{"file1", "file3"}) {
+		t.Errorf("GetAll() of active favorites fileIDs = %v, want [file1 file3]", got)
+	}
+
+}
+
+func testGetAllExpired(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	mustCreate(t, h, store, "file1", "user")
+	expiring := map[string]time.Time{"file2": now.Add(-time.Minute), "file3": now.Add(time.Hour), "file4": now.Add(-time.Minute)}
+	for fileID, expiresAt := range expiring {
+		if _, err := store.Create(ctx, &favorite{itemID: fileID, userID: "user", expiresAt: expiresAt}); err != nil {
+			t.Fatalf("Create(%s) error = %v", fileID, err)
+		}
+	}
+
+	// A deleted favorite that has expired isn't expired again.
+	deleted := service.FavoriteUpdate{DeletedAt: now, SetDeletedAt: true}
+	if _, err := store.Update(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file4"}}), deleted); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	favorites, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{ExpiredAt: now}), service.ListOptions{Sort: service.SortByFileID})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got := fileIDs(favorites); !equalStrings(got, []string{"file2"}) {
+		t.Errorf("GetAll() of expired favorites fileIDs = %v, want [file2]", got)
+	}
+
+}
+
+func testSoftDelete(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+
+	deletedAt := time.Now().UTC().Truncate(time.Millisecond)
+	deleted, err := store.Update(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file1"}}), service.FavoriteUpdate{DeletedAt: deletedAt, SetDeletedAt: true})
+	if err != nil {
+		t.Fatalf("Update() of the deletion time error = %v", err)
+	}
+
+	if !deleted.GetDeletedAt().Equal(deletedAt) {
+		t.Errorf("Update() deletedAt = %v, want %v", deleted.GetDeletedAt(), deletedAt)
+	}
+
+	active, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", ActiveAt: time.Now()}), service.ListOptions{Sort: service.SortByFileID})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got := fileIDs(active); !equalStrings(got, []string{"file2"}) {
+		t.Errorf("GetAll() of active favorites fileIDs = %v, want [file2]", got)
+	}
+
+	recentlyDeleted, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", DeletedAfter: deletedAt.Add(-time.Minute)}), service.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got := fileIDs(recentlyDeleted); !equalStrings(got, []string{"file1"}) {
+		t.Errorf("GetAll() of deleted favorites fileIDs = %v, want [file1]", got)
+	}
+
+	// Clearing the deletion time restores the favorite.
+	restored, err := store.Update(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file1"}}), service.FavoriteUpdate{SetDeletedAt: true})
+	if err != nil {
+		t.Fatalf("Update() of the deletion time error = %v", err)
+	}
+
+	if !restored.GetDeletedAt().IsZero() {
+		t.Errorf("Update() restored deletedAt = %v, want the zero time", restored.GetDeletedAt())
+	}
+
+	active, _, err = store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", ActiveAt: time.Now()}), service.ListOptions{Sort: service.SortByFileID})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got := fileIDs(active); !equalStrings(got, []string{"file1", "file2"}) {
+		t.Errorf("GetAll() of active favorites after restoring fileIDs = %v, want [file1 file2]", got)
+	}
+
+}
+
+func testGetAllRecentlyDeleted(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	mustCreate(t, h, store, "active", "user")
+	for fileID, deletedAt := range map[string]time.Time{
+		"file1": now.Add(-3 * time.Minute),
+		"file2": now.Add(-time.Minute),
+		"file3": now.Add(-2 * time.Minute),
+		"old":   now.Add(-time.Hour),
+	} {
+		if _, err := store.Create(ctx, &favorite{itemID: fileID, userID: "user", deletedAt: deletedAt}); err != nil {
+			t.Fatalf("Create(%s) error = %v", fileID, err)
+		}
+	}
+
+	filter := h.filter(service.FavoriteFilter{UserID: "user", DeletedAfter: now.Add(-10 * time.Minute)})
+	opts := service.ListOptions{Sort: service.SortRecentlyDeleted, PageSize: 2}
+
+	var got []string
+	for {
+		page, nextPageToken, err := store.GetAll(ctx, filter, opts)
+		if err != nil {
+			t.Fatalf("GetAll() error = %v", err)
+		}
+
+		got = append(got, fileIDs(page)...)
+		if nextPageToken == "" {
+			break
+		}
+
+		opts.PageToken = nextPageToken
+	}
+
+	if !equalStrings(got, []string{"file2", "file3", "file1"}) {
+		t.Errorf("GetAll() of recently deleted favorites fileIDs = %v, want [file2 file3 file1]", got)
+	}
+
+}
+
+func testAddToCollections(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+	mustCreate(t, h, store, "file3", "user")
+	mustCreate(t, h, store, "file1", "other")
+
+	matched, err := store.AddToCollections(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file1", "file2", "missing"}}), []string{"c1", "c2"})
+	if err != nil {
+		t.Fatalf("AddToCollections() error = %v", err)
+	}
+
+	if matched != 2 {
+		t.Errorf("AddToCollections() = %d, want 2", matched)
+	}
+
+	// Adding a favorite to a collection it's already in doesn't duplicate the collection.
+	if _, err := store.AddToCollections(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file1"}}), []string{"c1", "c3"}); err != nil {
+		t.Fatalf("AddToCollections() error = %v", err)
+	}
+
+	assertCollectionFileIDs(t, h, store, "user", "c1", "file1", "file2")
+	assertCollectionFileIDs(t, h, store, "user", "c2", "file1", "file2")
+	assertCollectionFileIDs(t, h, store, "user", "c3", "file1")
+	assertCollectionFileIDs(t, h, store, "other", "c1")
+
+	favorites, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file1"}}), service.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	collectionIDs := append([]string(nil), favorites[0].GetCollectionIDs()...)
+	sort.Strings(collectionIDs)
+	if !equalStrings(collectionIDs, []string{"c1", "c2", "c3"}) {
+		t.Errorf("GetCollectionIDs() = %v, want [c1 c2 c3]", collectionIDs)
+	}
+
+}
+
+func testRemoveFromCollections(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+
+	if _, err := store.AddToCollections(ctx, h.filter(service.FavoriteFilter{UserID: "user"}), []string{"c1", "c2"}); err != nil {
+		t.Fatalf("AddToCollections() error = %v", err)
+	}
+
+	matched, err := store.RemoveFromCollections(ctx, h.filter(service.FavoriteFilter{UserID: "user", ItemType: service.ItemTypeFile, ItemIDs: []string{"file1"}}), []string{"c1", "missing"})
+	if err != nil {
+		t.Fatalf("RemoveFromCollections() error = %v", err)
+	}
+
+	if matched != 1 {
+		t.Errorf("RemoveFromCollections() = %d, want 1", matched)
+	}
+
+	assertCollectionFileIDs(t, h, store, "user", "c1", "file2")
+	assertCollectionFileIDs(t, h, store, "user", "c2", "file1", "file2")
+	assertFileIDs(t, h, store, "user", "file1", "file2")
+
+}
+
+func testSetHidden(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+	mustCreate(t, h, store, "file1", "other")
+
+	matched, err := store.SetHidden(ctx, h.filter(service.FavoriteFilter{ItemType: service.ItemTypeFile, ItemIDs: []string{"file1"}}), true)
+	if err != nil {
+		t.Fatalf("SetHidden() error = %v", err)
+	}
+
+	if matched != 2 {
+		t.Errorf("SetHidden() = %d, want 2", matched)
+	}
+
+	for userID, want := range map[string][]string{"user": {"file2"}, "other": {}} {
+		active, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: userID, ActiveAt: time.Now()}), service.ListOptions{Sort: service.SortByFileID})
+		if err != nil {
+			t.Fatalf("GetAll() error = %v", err)
+		}
+
+		if got := fileIDs(active); !equalStrings(got, want) {
+			t.Errorf("GetAll() of active favorites of %s fileIDs = %v, want %v", userID, got, want)
+		}
+	}
+
+	// Hidden favorites are still stored.
+	assertFileIDs(t, h, store, "user", "file1", "file2")
+
+	if _, err := store.SetHidden(ctx, h.filter(service.FavoriteFilter{ItemType: service.ItemTypeFile, ItemIDs: []string{"file1"}}), false); err != nil {
+		t.Fatalf("SetHidden() error = %v", err)
+	}
+
+	active, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", ActiveAt: time.Now()}), service.ListOptions{Sort: service.SortByFileID})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got := fileIDs(active); !equalStrings(got, []string{"file1", "file2"}) {
+		t.Errorf("GetAll() of active favorites after unhiding fileIDs = %v, want [file1 file2]", got)
+	}
+
+}
+
+func testSetOrphaned(t *testing.T, h Harness) {
+	store := h.NewStore(t)
+	ctx := context.Background()
+
+	mustCreate(t, h, store, "file1", "user")
+	mustCreate(t, h, store, "file2", "user")
+	mustCreate(t, h, store, "file3", "user")
+
+	matched, err := store.SetOrphaned(ctx, h.filter(service.FavoriteFilter{ItemType: service.ItemTypeFile, ItemIDs: []string{"file1", "file2"}}), true)
+	if err != nil {
+		t.Fatalf("SetOrphaned() error = %v", err)
+	}
+
+	if matched != 2 {
+		t.Errorf("SetOrphaned() = %d, want 2", matched)
+	}
+
+	// Hiding a favorite makes it no longer orphaned.
+	if _, err := store.SetHidden(ctx, h.filter(service.FavoriteFilter{ItemType: service.ItemTypeFile, ItemIDs: []string{"file2"}}), true); err != nil {
+		t.Fatalf("SetHidden() error = %v", err)
+	}
+
+	now := time.Now()
+	orphanedFilter := service.FavoriteFilter{UserID: "user", ActiveAt: now, IncludeHidden: true, Hidden: true, Orphaned: true}
+	orphaned, _, err := store.GetAll(ctx, h.filter(orphanedFilter), service.ListOptions{Sort: service.SortByFileID})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got := fileIDs(orphaned); !equalStrings(got, []string{"file1"}) {
+		t.Errorf("GetAll() of orphaned favorites fileIDs = %v, want [file1]", got)
+	}
+
+	active, _, err := store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", ActiveAt: now}), service.ListOptions{Sort: service.SortByFileID})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got := fileIDs(active); !equalStrings(got, []string{"file3"}) {
+		t.Errorf("GetAll() of active favorites fileIDs = %v, want [file3]", got)
+	}
+
+	if _, err := store.SetOrphaned(ctx, h.filter(service.FavoriteFilter{UserID: "user", Orphaned: true}), false); err != nil {
+		t.Fatalf("SetOrphaned() error = %v", err)
+	}
+
+	active, _, err = store.GetAll(ctx, h.filter(service.FavoriteFilter{UserID: "user", ActiveAt: time.Now()}), service.ListOptions{Sort: service.SortByFileID})
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	if got := fileIDs(active); !equalStrings(got, []string{"file1", "file3"}) {
+		t.Errorf("GetAll() of active favorites after unorphaning fileIDs = %v, want [file1 file3]", got)
+	}
+
+}
+
+func testCollectionCreate(t *testing.T, h Harness) {
+	store := h.NewCollectionStore(t)
+	ctx := context.Background()
+
+	created, err := store.Create(ctx, service.Collection{UserID: "user", Name: "reports"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if created.ID == "" || created.UserID != "user" || created.Name != "reports" || created.CreatedAt.IsZero() {
+		t.Errorf("Create() = %+v, want a collection of user named reports with an ID and a creation time", created)
+	}
+
+	got, err := store.Get(ctx, "user", created.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if got.ID != created.ID || got.Name != created.Name || !got.CreatedAt.Equal(created.CreatedAt) {
+		t.Errorf("Get() = %+v, want %+v", got, created)
+	}
+
+	if _, err := store.Create(ctx, service.Collection{UserID: "user", Name: "reports"}); !errors.Is(err, service.ErrCollectionAlreadyExists) {
+		t.Errorf("Create() of an existing name error = %v, want %v", err, service.ErrCollectionAlreadyExists)
+	}
+
+	if _, err := store.Create(ctx, service.Collection{UserID: "other", Name: "reports"}); err != nil {
+		t.Errorf("Create() of an existing name of another user error = %v, want nil", err)
+	}
+
+	if _, err := store.Get(ctx, "other", created.ID); !errors.Is(err, service.ErrCollectionNotFound) {
+		t.Errorf("Get() of a collection of another user error = %v, want %v", err, service.ErrCollectionNotFound)
+	}
+
+	if _, err := store.Get(ctx, "user", "missing"); !errors.Is(err, service.ErrCollectionNotFound) {
+		t.Errorf("Get() of a missing collection error = %v, want %v", err, service.ErrCollectionNotFound)
+	}
+
+}
+
+func testCollectionGetAll(t *testing.T, h Harness) {
+	store := h.NewCollectionStore(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"b", "c", "a"} {
+		mustCreateCollection(t, store, "user", name)
+	}
+
+	mustCreateCollection(t, store, "other", "d")
+
+	collections, err := store.GetAll(ctx, "user")
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+
+	names := make([]string, 0, len(collections))
+	for _, collection := range collections {
+		names = append(names, collection.Name)
+	}
+
+	if !equalStrings(names, []string{"a", "b", "c"}) {
+		t.Errorf("GetAll() names = %v, want [a b c]", names)
+	}
+
+	empty, err := store.GetAll(ctx, "missing")
+	if err != nil || empty == nil || len(empty) != 0 {
+		t.Errorf("GetAll() of a user without collections = %v, %v, want an empty slice", empty, err)
+	}
+
+}
+
+func testCollectionRename(t *testing.T, h Harness) {
+	store := h.NewCollectionStore(t)
+	ctx := context.Background()
+
+	collection := mustCreateCollection(t, store, "user", "reports")
+	mustCreateCollection(t, store, "user", "onboarding")
+
+	renamed, err := store.Rename(ctx, "user", collection.ID, "q3 reports")
+	if err != nil {
+		t.Fatalf("Rename() error = %v", err)
+	}
+
+	if renamed.ID != collection.ID || renamed.Name != "q3 reports" || renamed.UpdatedAt.Before(collection.UpdatedAt) {
+		t.Errorf("Rename() = %+v, want %s named q3 reports", renamed, collection.ID)
+	}
+
+	if _, err := store.Rename(ctx, "user", collection.ID, "onboarding"); !errors.Is(err, service.ErrCollectionAlreadyExists) {
+		t.Errorf("Rename() to an existing name error = %v, want %v", err, service.ErrCollectionAlreadyExists)
+	}
+
+	if _, err := store.Rename(ctx, "other", collection.ID, "mine"); !errors.Is(err, service.ErrCollectionNotFound) {
+		t.Errorf("Rename() of a collection of another user error = %v, want %v", err, service.ErrCollectionNotFound)
+	}
+
+}
+
+func testCollectionDelete(t *testing.T, h Harness) {
+	store := h.NewCollectionStore(t)
+	ctx := context.Background()
+
+	collection := mustCreateCollection(t, store, "user", "reports")
+
+	if _, err := store.Delete(ctx, "other", collection.ID); !errors.Is(err, service.ErrCollectionNotFound) {
+		t.Errorf("Delete() of a collection of another user error = %v, want %v", err, service.ErrCollectionNotFound)
+	}
+
+	deleted, err := store.Delete(ctx, "user", collection.ID)
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if deleted.ID != collection.ID {
+		t.Errorf("Delete() = %+v, want %+v", deleted, collection)
+	}
+
+	if _, err := store.Get(ctx, "user", collection.ID); !errors.Is(err, service.ErrCollectionNotFound) {
+		t.Errorf("Get() of a deleted collection error = %v, want %v", err, service.ErrCollectionNotFound)
+	}
+
+}
+
+func testCollectionDeleteAll(t *testing.T, h Harness) {
+	store := h.NewCollectionStore(t)
+	ctx := context.Background()
+
+	mustCreateCollection(t, store, "user", "reports")
+	mustCreateCollection(t, store, "user", "drafts")
+	other := mustCreateCollection(t, store, "other", "reports")
+
+	deletedCount, err := store.DeleteAll(ctx, "user")
+	if err != nil {
+		t.Fatalf("DeleteAll() error = %v", err)
+	}
+
+	if deletedCount != 2 {
+		t.Errorf("DeleteAll() = %d, want 2", deletedCount)
+	}
+
+	if collections, err := store.GetAll(ctx, "user"); err != nil || len(collections) != 0 {
+		t.Errorf("GetAll() after DeleteAll() = %+v, %v, want no collections", collections, err)
+	}
+
+	if _, err := store.Get(ctx, "other", other.ID); err != nil {
+		t.Errorf("Get() of a collection of another user error = %v", err)
+	}
+
+}
+
+func testQuotaLimit(t *testing.T, h Harness) {
+	store := h.NewQuotaStore(t)
+	ctx := context.Background()
+
+	quota, err := store.Get(ctx, "user")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if quota.UserID != "user" || quota.Limit != 0 || quota.Version != 0 {
+		t.Errorf("Get() of a missing quota = %+v, want a quota of user without a limit", quota)
+	}
+
+	if err := store.SetLimit(ctx, "user", 5); err != nil {
+		t.Fatalf("SetLimit() error = %v", err)
+	}
+
+	if quota, err := store.Get(ctx, "user"); err != nil || quota.Limit != 5 {
+		t.Errorf("Get() = %+v, %v, want a limit of 5", quota, err)
+	}
+
+	if quota, err := store.Get(ctx, "other"); err != nil || quota.Limit != 0 {
+		t.Errorf("Get() of another user = %+v, %v, want no limit", quota, err)
+	}
+
+	if err := store.SetLimit(ctx, "user", service.UnlimitedQuota); err != nil {
+		t.Fatalf("SetLimit() to UnlimitedQuota error = %v", err)
+	}
+
+	if quota, err := store.Get(ctx, "user"); err != nil || quota.Limit != service.UnlimitedQuota {
+		t.Errorf("Get() = %+v, %v, want a limit of UnlimitedQuota", quota, err)
+	}
+
+	if err := store.SetLimit(ctx, "user", 0); err != nil {
+		t.Fatalf("SetLimit() to 0 error = %v", err)
+	}
+
+	if quota, err := store.Get(ctx, "user"); err != nil || quota.Limit != 0 {
+		t.Errorf("Get() after removing the limit = %+v, %v, want no limit", quota, err)
+	}
+
+}
+
+func testQuotaVersion(t *testing.T, h Harness) {
+	store := h.NewQuotaStore(t)
+	ctx := context.Background()
+
+	incremented, err := store.IncrementVersion(ctx, "user", 0)
+	if err != nil || !incremented {
+		t.Fatalf("IncrementVersion() of a missing quota = %v, %v, want true", incremented, err)
+	}
+
+	if incremented, err := store.IncrementVersion(ctx, "user", 0); err != nil || incremented {
+		t.Errorf("IncrementVersion() of a changed version = %v, %v, want false", incremented, err)
+	}
+
+	if err := store.SetLimit(ctx, "user", 5); err != nil {
+		t.Fatalf("SetLimit() error = %v", err)
+	}
+
+	quota, err := store.Get(ctx, "user")
+	if err != nil || quota.Version != 1 || quota.Limit != 5 {
+		t.Fatalf("Get() = %+v, %v, want version 1 and a limit of 5", quota, err)
+	}
+
+	if incremented, err := store.IncrementVersion(ctx, "user", 1); err != nil || !incremented {
+		t.Errorf("IncrementVersion() = %v, %v, want true", incremented, err)
+	}
+
+	if quota, err := store.Get(ctx, "user"); err != nil || quota.Version != 2 || quota.Limit != 5 {
+		t.Errorf("Get() = %+v, %v, want version 2 and a limit of 5", quota, err)
+	}
+
+}
+
+func testQuotaDelete(t *testing.T, h Harness) {
+	store := h.NewQuotaStore(t)
+	ctx := context.Background()
+
+	if err := store.Delete(ctx, "user"); err != nil {
+		t.Fatalf("Delete() of a missing quota error = %v", err)
+	}
+
+	for _, userID := range []string{"user", "other"} {
+		if err := store.SetLimit(ctx, userID, 5); err != nil {
+			t.Fatalf("SetLimit() error = %v", err)
+		}
+	}
+
+	if err := store.Delete(ctx, "user"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if quota, err := store.Get(ctx, "user"); err != nil || quota.Limit != 0 || quota.Version != 0 {
+		t.Errorf("Get() after Delete() = %+v, %v, want a quota without a limit", quota, err)
+	}
+
+	if quota, err := store.Get(ctx, "other"); err != nil || quota.Limit != 5 {
+		t.Errorf("Get() of another user = %+v, %v, want a limit of 5", quota, err)
+	}
+
+}
+
+func testOutboxClaim(t *testing.T, h Harness) {
+	outbox := h.NewOutbox(t)
+	ctx := context.Background()
+
+	events := []service.Event{
+		{Type: service.EventFavoriteCreated, UserID: "user", ItemType: service.ItemTypeFile, ItemID: "a"},
+		{Type: service.EventFavoriteCreated, UserID: "user", ItemType: service.ItemTypeFile, ItemID: "b"},
+		{Type: service.EventFavoriteDeleted, UserID: "user", ItemType: service.ItemTypeFile, ItemID: "a"},
+	}
+
+	if err := outbox.Add(ctx, events); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	for _, event := range events {
+		if event.ID == "" {
+			t.Fatalf("Add() didn't set the ID of %+v", event)
+		}
+	}
+
+	claimed, err := outbox.Claim(ctx, 2, time.Hour)
+	if err != nil {
+		t.Fatalf("Claim() error = %v", err)
+	}
+
+	if len(claimed) != 2 || claimed[0].ID != events[0].ID || claimed[1].ID != events[1].ID {
+		t.Fatalf("Claim() = %+v, want the first 2 events", claimed)
+	}
+
+	if claimed[0].Type != service.EventFavoriteCreated || claimed[0].UserID != "user" || claimed[0].ItemID != "a" {
+		t.Errorf("Claim() first event = %+v, want %+v", claimed[0], events[0])
+	}
+
+	claimed, err = outbox.Claim(ctx, 10, time.Hour)
+	if err != nil || len(claimed) != 1 || claimed[0].ID != events[2].ID {
+		t.Errorf("Claim() while the first events are claimed = %+v, %v, want the last event", claimed, err)
+	}
+
+	claimed, err = outbox.Claim(ctx, 10, time.Hour)
+	if err != nil || len(claimed) != 0 {
+		t.Errorf("Claim() while all events are claimed = %+v, %v, want no events", claimed, err)
+	}
+
+}
+
+func testOutboxDelete(t *testing.T, h Harness) {
+	outbox := h.NewOutbox(t)
+	ctx := context.Background()
+
+	events := []service.Event{
+		{Type: service.EventFavoriteCreated, UserID: "user", ItemType: service.ItemTypeFile, ItemID: "a"},
+		{Type: service.EventFavoriteCreated, UserID: "user", ItemType: service.ItemTypeFile, ItemID: "b"},
+	}
+
+	if err := outbox.Add(ctx, events); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	// A lease that has already ended lets the events be claimed again.
+	if claimed, err := outbox.Claim(ctx, 10, -time.Second); err != nil || len(claimed) != 2 {
+		t.Fatalf("Claim() = %+v, %v, want 2 events", claimed, err)
+	}
+
+	if err := outbox.Delete(ctx, []string{events[0].ID}); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	claimed, err := outbox.Claim(ctx, 10, time.Hour)
+	if err != nil || len(claimed) != 1 || claimed[0].ID != events[1].ID {
+		t.Errorf("Claim() after Delete() = %+v, %v, want the event that wasn't deleted", claimed, err)
+	}
+
+}
+
+// mustCreate creates the favorite of fileID and userID in store and fails the test on error.
+func mustCreate(t *testing.T, h Harness, store service.Store, fileID string, userID string) service.Favorite {
+	t.Helper()
+
+	created, err := store.Create(context.Background(), &favorite{itemID: fileID, userID: userID})
+	if err != nil {
+		t.Fatalf("Create(%s, %s) error = %v", fileID, userID, err)
+	}
+
+	return created
+
+}
+
+// mustCreateCollection creates the collection of userID named name in store and fails the test on error.
+func mustCreateCollection(t *testing.T, store service.CollectionStore, userID string, name string) service.Collection {
+	t.Helper()
+
+	created, err := store.Create(context.Background(), service.Collection{UserID: userID, Name: name})
+	if err != nil {
+		t.Fatalf("Create(%s, %s) error = %v", userID, name, err)
+	}
+
+	return created
+
+}
+
+// assertCollectionFileIDs asserts that the favorite fileIDs of userID in the collection of collectionID
+// are exactly fileIDs, in any order.
+func assertCollectionFileIDs(t *testing.T, h Harness, store service.Store, userID string, collectionID string, wantFileIDs ...string) {
+	t.Helper()
+
+	favorites, _, err := store.GetAll(context.Background(), h.filter(service.FavoriteFilter{UserID: userID, CollectionID: collectionID}), service.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetAll(%s, %s) error = %v", userID, collectionID, err)
+	}
+
+	got := fileIDs(favorites)
+	want := append([]string(nil), wantFileIDs...)
+	sort.Strings(got)
+	sort.Strings(want)
+
+	if !equalStrings(got, want) {
+		t.Fatalf("GetAll(%s, %s) fileIDs = %v, want %v", userID, collectionID, got, want)
+	}
+
+}
+
+// assertFileIDs asserts that the favorite fileIDs of userID in store are exactly fileIDs, in any order.
+func assertFileIDs(t *testing.T, h Harness, store service.Store, userID string, wantFileIDs ...string) {
+	t.Helper()
+
+	favorites, nextPageToken, err := store.GetAll(context.Background(), h.filter(service.FavoriteFilter{UserID: userID}), service.ListOptions{})
+	if err != nil {
+		t.Fatalf("GetAll(%s) error = %v", userID, err)
+	}
+
+	if nextPageToken != "" {
+		t.Errorf("GetAll(%s) without a page size returned next page token %q", userID, nextPageToken)
+	}
+
+	for _, favorite := range favorites {
+		if favorite.GetUserID() != userID {
+			t.Errorf("GetAll(%s) returned a favorite of user %s", userID, favorite.GetUserID())
+		}
+	}
+
+	got := fileIDs(favorites)
+	want := append([]string(nil), wantFileIDs...)
+	sort.Strings(got)
+	sort.Strings(want)
+
+	if !equalStrings(got, want) {
+		t.Fatalf("GetAll(%s) fileIDs = %v, want %v", userID, got, want)
+	}
+
+}
+
+// assertManualOrder asserts that the favorite fileIDs of userID in store in SortManual order are wantFileIDs.
+func assertManualOrder(t *testing.T, h Harness, store service.Store, userID string, wantFileIDs ...string) {
+	t.Helper()
+
+	favorites, _, err := store.GetAll(context.Background(), h.filter(service.FavoriteFilter{UserID: userID}), service.ListOptions{Sort: service.SortManual})
+	if err != nil {
+		t.Fatalf("GetAll(%s) error = %v", userID, err)
+	}
+
+	if got := fileIDs(favorites); !equalStrings(got, wantFileIDs) {
+		t.Fatalf("GetAll(%s) in manual order = %v, want %v", userID, got, wantFileIDs)
+	}
+
+}
+
+// fileIDs returns the fileIDs of favorites in order.
+func fileIDs(favorites []service.Favorite) []string {
+	ids := make([]string, 0, len(favorites))
+	for _, favorite := range favorites {
+		ids = append(ids, favorite.GetFileID())
+	}
+
+	return ids
+
+}
+
+// equalStrings returns true if a and b hold the same strings in the same order.
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	pb "github.com/meateam/fav-service/proto"
+)
+
+// The types of the changes of favorites.
+const (
+	ChangeCreated = "CREATED"
+	ChangeUpdated = "UPDATED"
+	ChangeDeleted = "DELETED"
+)
+
+const (
+	// BroadcastHistorySize is the number of the latest changes kept by a Broadcaster for resuming watchers.
+	BroadcastHistorySize = 10000
+
+	// BroadcastBufferSize is the number of changes buffered for a watcher, a watcher that falls behind
+	// by more changes is stopped and may resume from the last change it received.
+	BroadcastBufferSize = 256
+)
+
+var (
+	// ErrInvalidResumeToken is returned when watching from a resume token that is malformed or has expired.
+	ErrInvalidResumeToken = errors.New("invalid resume token, reload the favorites and watch without a resume token")
+
+	// errWatcherFellBehind is the error of a watcher that was stopped for falling behind the changes.
+	errWatcherFellBehind = NewAbortedError("watcher fell behind the changes, resume from the last resume token", nil)
+)
+
+// changeTypes maps the type of a Change to its pb.ChangeType.
+var changeTypes = map[string]pb.ChangeType{
+	ChangeCreated: pb.ChangeType_CHANGE_CREATED,
+	ChangeUpdated: pb.ChangeType_CHANGE_UPDATED,
+	ChangeDeleted: pb.ChangeType_CHANGE_DELETED,
+}
+
+// Change is a change of a favorite.
+type Change struct {
+	Type string
+
+	// Favorite is the favorite after the change.
+	Favorite Favorite
+
+	// ResumeToken identifies the change, watching from it streams the changes after it.
+	ResumeToken string
+}
+
+// MarshalProto marshals c into change.
+func (c Change) MarshalProto(change *pb.FavoriteChange) error {
+	change.Type = changeTypes[c.Type]
+	change.ResumeToken = c.ResumeToken
+	change.Favorite = &pb.FavoriteObject{}
+
+	return c.Favorite.MarshalProto(change.Favorite)
+
+}
+
+// broadcastWatcher is a watcher of the changes of the favorites of userID.
+type broadcastWatcher struct {
+	userID  string
+	changes chan Change
+
+	// fellBehind is closed when the watcher is stopped for falling behind the changes.
+	fellBehind chan struct{}
+}
+
+// Broadcaster broadcasts the changes of favorites to the watchers of their users in the same process.
+// It keeps at least the latest BroadcastHistorySize changes, so a watcher may resume from a change it received
+// unless too many changes happened since. Resume tokens identify the Broadcaster, since the changes of
+// another process or of a previous run of the process can't be resumed from.
+type Broadcaster struct {
+	mu       sync.Mutex
+	id       string
+	lastSeq  uint64
+	history  []Change
+	watchers map[*broadcastWatcher]struct{}
+}
+
+// NewBroadcaster returns a new Broadcaster without changes.
+func NewBroadcaster() *Broadcaster {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		panic(fmt.Sprintf("failed generating broadcaster id: %v", err))
+	}
+
+	return &Broadcaster{
+		id:       hex.EncodeToString(id),
+		watchers: make(map[*broadcastWatcher]struct{}),
+	}
+
+}
+
+// Publish broadcasts the changes of changeType of favorites to the watchers of their users.
+func (b *Broadcaster) Publish(changeType string, favorites ...Favorite) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, favorite := range favorites {
+		b.lastSeq++
+		change := Change{
+			Type:        changeType,
+			Favorite:    favorite,
+			ResumeToken: b.id + "-" + strconv.FormatUint(b.lastSeq, 10),
+		}
+
+		// The oldest changes are dropped in bulk, so the history is copied only once in BroadcastHistorySize changes.
+		if len(b.history) == 2*BroadcastHistorySize {
+			b.history = append(b.history[:0], b.history[BroadcastHistorySize:]...)
+		}
+
+		b.history = append(b.history, change)
+
+		for watcher := range b.watchers {
+			if watcher.userID != favorite.GetUserID() {
+				continue
+			}
+
+			select {
+			case watcher.changes <- change:
+			default:
+				delete(b.watchers, watcher)
+				close(watcher.fellBehind)
+			}
+		}
+	}
+
+}
+
+// Watch calls fn for each change of the favorites of userID, starting after the change of resumeToken
+// if it's set, until ctx is done or fn returns an error.
+// Returns ErrInvalidResumeToken if resumeToken wasn't returned by b or too many changes happened since.
+func (b *Broadcaster) Watch(ctx context.Context, userID string, resumeToken string, fn func(Change) error) error {
+	b.mu.Lock()
+	missed, err := b.changesAfter(userID, resumeToken)
+	if err != nil {
+		b.mu.Unlock()
+		return err
+	}
+
+	watcher := &broadcastWatcher{
+		userID:     userID,
+		changes:    make(chan Change, BroadcastBufferSize),
+		fellBehind: make(chan struct{}),
+	}
+	b.watchers[watcher] = struct{}{}
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		delete(b.watchers, watcher)
+		b.mu.Unlock()
+	}()
+
+	for _, change := range missed {
+		if err := fn(change); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case change := <-watcher.changes:
+			if err := fn(change); err != nil {
+				return err
+			}
+		case <-watcher.fellBehind:
+			return errWatcherFellBehind
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+}
+
+// changesAfter returns the changes of the favorites of userID in the history of b after the change
+// of resumeToken, or no changes if resumeToken is empty. b.mu must be held.
+func (b *Broadcaster) changesAfter(userID string, resumeToken string) ([]Change, error) {
+	if resumeToken == "" {
+		return nil, nil
+	}
+
+	parts := strings.SplitN(resumeToken, "-", 2)
+	if len(parts) != 2 || parts[0] != b.id {
+		return nil, ErrInvalidResumeToken
+	}
+
+	seq, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil || seq > b.lastSeq {
+		return nil, ErrInvalidResumeToken
+	}
+
+	// firstSeq is the sequence number of the oldest change in the history.
+	firstSeq := b.lastSeq - uint64(len(b.history)) + 1
+	if seq+1 < firstSeq {
+		return nil, ErrInvalidResumeToken
+	}
+
+	var changes []Change
+	for _, change := range b.history[seq+1-firstSeq:] {
+		if change.Favorite.GetUserID() == userID {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil
+
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// errStopWatching stops watching from the fn of a watcher.
+var errStopWatching = errors.New("stop watching")
+
+// publishFile publishes the change of changeType of the favorite of fileID of userID with b
+// and returns its resume token.
+func publishFile(b *Broadcaster, changeType string, fileID string, userID string) string {
+	b.Publish(changeType, &favoriteValue{itemType: ItemTypeFile, itemID: fileID, userID: userID})
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.history[len(b.history)-1].ResumeToken
+
+}
+
+// watchChanges watches the changes of userID with b from resumeToken until count changes are received,
+// and returns the received changes.
+func watchChanges(b *Broadcaster, userID string, resumeToken string, count int) ([]Change, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var changes []Change
+	err := b.Watch(ctx, userID, resumeToken, func(change Change) error {
+		changes = append(changes, change)
+		if len(changes) == count {
+			return errStopWatching
+		}
+
+		return nil
+	})
+	if err != errStopWatching {
+		return nil, fmt.Errorf("Watch() error = %v, want %d changes", err, count)
+	}
+
+	return changes, nil
+
+}
+
+// mustWatchChanges is watchChanges that fails t if the changes aren't received.
+func mustWatchChanges(t *testing.T, b *Broadcaster, userID string, resumeToken string, count int) []Change {
+	t.Helper()
+
+	changes, err := watchChanges(b, userID, resumeToken, count)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return changes
+
+}
+
+func TestBroadcasterResume(t *testing.T) {
+	b := NewBroadcaster()
+
+	first := publishFile(b, ChangeCreated, "file1", "user")
+	publishFile(b, ChangeCreated, "file2", "other")
+	publishFile(b, ChangeUpdated, "file1", "user")
+	last := publishFile(b, ChangeDeleted, "file1", "user")
+
+	changes := mustWatchChanges(t, b, "user", first, 2)
+	if changes[0].Type != ChangeUpdated || changes[1].Type != ChangeDeleted {
+		t.Errorf("Watch() change types = %s, %s, want the changes of user after the resume token", changes[0].Type, changes[1].Type)
+	}
+
+	if changes[1].ResumeToken != last {
+		t.Errorf("Watch() last resume token = %s, want %s", changes[1].ResumeToken, last)
+	}
+
+}
+
+func TestBroadcasterWatchNewChanges(t *testing.T) {
+	b := NewBroadcaster()
+	resumeToken := publishFile(b, ChangeCreated, "file1", "user")
+
+	var changes []Change
+	watchErr := make(chan error)
+	go func() {
+		var err error
+		changes, err = watchChanges(b, "user", resumeToken, 1)
+		watchErr <- err
+	}()
+
+	// The change is received whether it's published before or after the watcher starts watching.
+	publishFile(b, ChangeCreated, "file2", "other")
+	publishFile(b, ChangeCreated, "file2", "user")
+
+	if err := <-watchErr; err != nil {
+		t.Fatal(err)
+	}
+
+	if changes[0].Favorite.GetFileID() != "file2" || changes[0].Favorite.GetUserID() != "user" {
+		t.Errorf("Watch() change = %+v, want the change of file2 of user", changes[0])
+	}
+
+}
+
+func TestBroadcasterInvalidResumeToken(t *testing.T) {
+	b := NewBroadcaster()
+	resumeToken := publishFile(b, ChangeCreated, "file1", "user")
+
+	tests := []struct {
+		name        string
+		resumeToken string
+	}{
+		{name: "Malformed", resumeToken: "malformed"},
+		{name: "OtherBroadcaster", resumeToken: publishFile(NewBroadcaster(), ChangeCreated, "file1", "user")},
+		{name: "Future", resumeToken: resumeToken[:len(resumeToken)-1] + "2"},
+		{name: "NotANumber", resumeToken: resumeToken[:len(resumeToken)-1] + "x"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := b.Watch(context.Background(), "user", tt.resumeToken, func(Change) error { return nil })
+			if err != ErrInvalidResumeToken {
+				t.Errorf("Watch() error = %v, want %v", err, ErrInvalidResumeToken)
+			}
+		})
+	}
+
+}
+
+func TestBroadcasterHistoryExpiry(t *testing.T) {
+	b := NewBroadcaster()
+
+	expired := publishFile(b, ChangeCreated, "file1", "user")
+	for i := 0; i < 2*BroadcastHistorySize; i++ {
+		publishFile(b, ChangeUpdated, "file1", "other")
+	}
+
+	kept := publishFile(b, ChangeUpdated, "file1", "user")
+	publishFile(b, ChangeDeleted, "file1", "user")
+
+	err := b.Watch(context.Background(), "user", expired, func(Change) error { return nil })
+	if err != ErrInvalidResumeToken {
+		t.Errorf("Watch() from an expired change error = %v, want %v", err, ErrInvalidResumeToken)
+	}
+
+	changes := mustWatchChanges(t, b, "user", kept, 1)
+	if changes[0].Type != ChangeDeleted {
+		t.Errorf("Watch() from a kept change type = %s, want %s", changes[0].Type, ChangeDeleted)
+	}
+
+}
+
+func TestBroadcasterWatcherFellBehind(t *testing.T) {
+	b := NewBroadcaster()
+	resumeToken := publishFile(b, ChangeCreated, "file1", "user")
+	publishFile(b, ChangeUpdated, "file1", "user")
+
+	blocked := make(chan struct{})
+	release := make(chan struct{})
+	watchErr := make(chan error)
+	go func() {
+		received := 0
+		watchErr <- b.Watch(context.Background(), "user", resumeToken, func(Change) error {
+			received++
+			if received == 1 {
+				close(blocked)
+				<-release
+			}
+
+			return nil
+		})
+	}()
+
+	// The watcher is registered before it receives the missed change, which blocks it.
+	<-blocked
+	for i := 0; i <= BroadcastBufferSize; i++ {
+		publishFile(b, ChangeUpdated, "file1", "user")
+	}
+
+	close(release)
+	if err := <-watchErr; err != errWatcherFellBehind {
+		t.Errorf("Watch() error = %v, want %v", err, errWatcherFellBehind)
+	}
+
+}